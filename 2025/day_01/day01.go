@@ -0,0 +1,152 @@
+package day01
+
+import (
+	_ "embed"
+	"fmt"
+
+	"github.com/digdon/2025aoc/solver"
+)
+
+//go:embed example.txt
+var example string
+
+func init() {
+	solver.Register(2025, 1, func() solver.Solver { return &Solver{} })
+	solver.RegisterExamples(2025, 1, solver.Example{Input: example, Part1: 3, Part2: 6})
+}
+
+type Solver struct {
+	dials   []dialSpec
+	program []instruction
+}
+
+// DialCount is how often one dial reached 0
+type DialCount struct {
+	Name string
+	Marks
+}
+
+// Parse reads the puzzle's rotations, along with the extended instruction language described in language.go
+func (s *Solver) Parse(lines []string) error {
+	var err error
+	s.dials, s.program, err = parseProgram(lines)
+	return err
+}
+
+func (s *Solver) Part1() (any, error) {
+	counts, err := s.spin()
+	total := 0
+
+	for _, c := range counts {
+		total += c.Landed
+	}
+
+	return total, err
+}
+
+func (s *Solver) Part2() (any, error) {
+	counts, err := s.spin()
+	total := 0
+
+	for _, c := range counts {
+		total += c.Total()
+	}
+
+	return total, err
+}
+
+// Counts returns how often each dial reached 0, in the order the dials were first mentioned
+func (s *Solver) Counts() ([]DialCount, error) {
+	return s.spin()
+}
+
+// Events returns a record of every rotation and set, for checking exactly where the dials pass 0
+func (s *Solver) Events() ([]Event, error) {
+	var events []Event
+
+	_, err := s.spinWith(func(e Event) { events = append(events, e) })
+
+	return events, err
+}
+
+func (s *Solver) spin() ([]DialCount, error) {
+	return s.spinWith(nil)
+}
+
+// Every dial is watching for 0, which is what the password is all about
+func (s *Solver) spinWith(record func(Event)) ([]DialCount, error) {
+	dials := make([]*Dial, len(s.dials))
+
+	for i, spec := range s.dials {
+		dial, err := NewDial(spec.size, spec.start, 0)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", describeDial(spec.name), err)
+		}
+
+		dials[i] = dial
+	}
+
+	if err := s.run(s.program, dials, record); err != nil {
+		return nil, err
+	}
+
+	counts := make([]DialCount, len(s.dials))
+
+	for i, spec := range s.dials {
+		counts[i] = DialCount{Name: spec.name, Marks: dials[i].Marks(0)}
+	}
+
+	return counts, nil
+}
+
+func (s *Solver) run(program []instruction, dials []*Dial, record func(Event)) error {
+	for _, inst := range program {
+		if inst.op == opRepeat {
+			for range inst.count {
+				if err := s.run(inst.body, dials, record); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		for _, i := range inst.dials {
+			var event Event
+			var err error
+
+			if inst.op == opSet {
+				event, err = dials[i].Set(inst.count)
+			} else {
+				event, err = dials[i].Turn(inst.dir, inst.count)
+			}
+
+			if err != nil {
+				return fmt.Errorf("line %d: %w", inst.line, err)
+			}
+
+			if record != nil {
+				event.Line, event.Dial = inst.line, s.dials[i].name
+				record(event)
+			}
+		}
+	}
+
+	return nil
+}
+
+// turn is the puzzle's own rotation, done with a Dial of 100 watching 0: where the dial ends up after turning
+// from pos, and how many times it passed 0 on the way. Finishing on 0 is a landing rather than a pass.
+func turn(pos int, dir rune, count int) (int, int, error) {
+	dial, err := NewDial(defaultSize, pos, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	event, err := dial.Turn(dir, count)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return event.End, event.Passes, nil
+}
@@ -0,0 +1,95 @@
+package day01
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/solver"
+	"github.com/digdon/2025aoc/solver/solvertest"
+)
+
+func FuzzParse(f *testing.F) {
+	solvertest.FuzzParse(f, 2025, 1, func() solver.Solver { return &Solver{} })
+}
+
+func TestRepeatLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		program string
+		ok      bool
+	}{
+		{"within the limit", "repeat 1000 {\nrepeat 1000 {\nR1\n}\n}", true},
+		{"one huge repeat", "repeat 1000000000 {\nR1\n}", false},
+		{"nested repeats", "repeat 1000000000 {\nrepeat 1000000000 {\nrepeat 1000000000 {\nR1\n}\n}\n}", false},
+		{"empty repeats", "repeat 100000 {\nrepeat 100000 {\n}\n}", false},
+		{"repeats that only add up to too much", "repeat 3000000 {\nR1\n}\nrepeat 3000000 {\nR1\n}", false},
+		{"repeats past the int range", "repeat 9223372036854775807 {\nrepeat 9223372036854775807 {\nR1\n}\n}", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Solver{}).Parse(input.Split(tt.program))
+
+			if tt.ok && err != nil {
+				t.Errorf("rejected: %v", err)
+			} else if !tt.ok && err == nil {
+				t.Error("accepted")
+			}
+		})
+	}
+}
+
+func TestTurn(t *testing.T) {
+	tests := []struct {
+		pos    int
+		dir    rune
+		count  int
+		end    int
+		passes int
+	}{
+		{50, 'L', 68, 82, 1},
+		{50, 'R', 50, 0, 0},
+		{50, 'R', 1000, 50, 10},
+		{0, 'L', 5, 95, 0},
+		{99, 'R', 1, 0, 0},
+
+		// Whole laps from 0 finish on 0, so the last time round is the landing rather than a pass
+		{0, 'R', 100, 0, 0},
+		{0, 'L', 200, 0, 1},
+		{0, 'R', 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%c%d from %d", tt.dir, tt.count, tt.pos), func(t *testing.T) {
+			end, passes, err := turn(tt.pos, tt.dir, tt.count)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if end != tt.end || passes != tt.passes {
+				t.Errorf("got end %d with %d passes, want %d with %d", end, passes, tt.end, tt.passes)
+			}
+		})
+	}
+
+	if _, _, err := turn(50, 'X', 5); err == nil {
+		t.Error("turned in an unknown direction")
+	}
+}
+
+// Whole laps that start on 0 finish there, which is one landing and not a pass as well
+func TestLapsFromZero(t *testing.T) {
+	s := &Solver{}
+	if err := s.Parse([]string{"L50", "R100", "L200"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := s.Part1(); err != nil || got != 3 {
+		t.Errorf("part 1 gave %v, %v, want 3", got, err)
+	}
+
+	if got, err := s.Part2(); err != nil || got != 4 {
+		t.Errorf("part 2 gave %v, %v, want 4", got, err)
+	}
+}
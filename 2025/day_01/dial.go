@@ -0,0 +1,159 @@
+package day01
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Dial is a lock dial with positions 0 to size-1 that wraps around in both directions. It keeps track of
+// how often each of its target positions is reached.
+type Dial struct {
+	size    int
+	pos     int
+	targets []int
+	counts  map[int]Marks
+}
+
+// Marks counts how often a target position was reached
+type Marks struct {
+	// Rotations that finished on the target
+	Landed int
+
+	// Clicks that pointed at the target part way through a rotation
+	Passed int
+}
+
+// Event describes a single rotation of the dial
+type Event struct {
+	// Input line the rotation came from and the dial it turned, when the caller knows them
+	Line int    `json:"line,omitempty"`
+	Dial string `json:"dial,omitempty"`
+
+	// L or R, or = when the dial was set to a position (which is then the distance)
+	Direction string `json:"direction"`
+	Distance  int    `json:"distance"`
+	Start     int    `json:"start"`
+	End       int    `json:"end"`
+
+	// Whether the rotation finished on a target, and how many times it passed over one on the way
+	Landed bool `json:"landed"`
+	Passes int  `json:"passes"`
+}
+
+// Total is every time the target was reached, whether a rotation finished there or not
+func (m Marks) Total() int {
+	return m.Landed + m.Passed
+}
+
+// NewDial returns a dial of the given size, pointing at start, watching the target positions
+func NewDial(size, start int, targets ...int) (*Dial, error) {
+	if size < 1 {
+		return nil, fmt.Errorf("invalid dial size %d", size)
+	}
+
+	if start < 0 || start >= size {
+		return nil, fmt.Errorf("start position %d is off a dial of size %d", start, size)
+	}
+
+	d := &Dial{size: size, pos: start, counts: map[int]Marks{}}
+
+	for _, t := range targets {
+		if t < 0 || t >= size {
+			return nil, fmt.Errorf("target position %d is off a dial of size %d", t, size)
+		}
+
+		if _, dup := d.counts[t]; !dup {
+			d.targets = append(d.targets, t)
+			d.counts[t] = Marks{}
+		}
+	}
+
+	slices.Sort(d.targets)
+
+	return d, nil
+}
+
+// Turn rotates the dial count clicks to the left (towards lower numbers) or right, returning what happened
+func (d *Dial) Turn(dir rune, count int) (Event, error) {
+	if count < 0 {
+		return Event{}, fmt.Errorf("invalid click count %d", count)
+	}
+
+	step := 1
+
+	switch dir {
+	case 'L':
+		step = -1
+	case 'R':
+	default:
+		return Event{}, fmt.Errorf("unknown direction %q", dir)
+	}
+
+	end := mod(d.pos+step*(count%d.size), d.size)
+	event := Event{Direction: string(dir), Distance: count, Start: d.pos, End: end}
+
+	for _, t := range d.targets {
+		// The first click that reaches the target, then once more for every full turn after that
+		first := mod(step*(t-d.pos), d.size)
+		if first == 0 {
+			first = d.size
+		}
+
+		hits := 0
+		if first <= count {
+			hits = 1 + (count-first)/d.size
+		}
+
+		m := d.counts[t]
+
+		if t == end {
+			m.Landed++
+			event.Landed = true
+
+			// The last click is the landing, not a pass
+			if hits > 0 {
+				hits--
+			}
+		}
+
+		m.Passed += hits
+		d.counts[t] = m
+		event.Passes += hits
+	}
+
+	d.pos = end
+
+	return event, nil
+}
+
+// Set points the dial straight at a position. It doesn't count as a rotation, so no targets are reached.
+func (d *Dial) Set(pos int) (Event, error) {
+	if pos < 0 || pos >= d.size {
+		return Event{}, fmt.Errorf("position %d is off a dial of size %d", pos, d.size)
+	}
+
+	event := Event{Direction: "=", Distance: pos, Start: d.pos, End: pos}
+	d.pos = pos
+
+	return event, nil
+}
+
+// Position is where the dial is currently pointing
+func (d *Dial) Position() int {
+	return d.pos
+}
+
+// Targets returns the watched positions, in order
+func (d *Dial) Targets() []int {
+	return slices.Clone(d.targets)
+}
+
+// Marks returns the counts for a target. Positions that aren't being watched always come back empty.
+func (d *Dial) Marks(target int) Marks {
+	return d.counts[target]
+}
+
+// Like %, but always non-negative
+func mod(a, n int) int {
+	return ((a % n) + n) % n
+}
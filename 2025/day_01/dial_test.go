@@ -0,0 +1,270 @@
+package day01
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// referenceDial moves one click at a time, checking every position it points at. It's far too slow for
+// real use, but simple enough to trust, so it's the yardstick for Dial's arithmetic.
+type referenceDial struct {
+	size    int
+	pos     int
+	targets []int
+	counts  map[int]Marks
+}
+
+func newReferenceDial(size, start int, targets []int) *referenceDial {
+	r := &referenceDial{size: size, pos: start, counts: map[int]Marks{}}
+
+	for _, t := range targets {
+		if !slices.Contains(r.targets, t) {
+			r.targets = append(r.targets, t)
+		}
+	}
+
+	return r
+}
+
+func (r *referenceDial) turn(dir rune, count int) Event {
+	event := Event{Direction: string(dir), Distance: count, Start: r.pos}
+
+	for click := 1; click <= count; click++ {
+		if dir == 'L' {
+			r.pos--
+			if r.pos < 0 {
+				r.pos = r.size - 1
+			}
+		} else {
+			r.pos++
+			if r.pos == r.size {
+				r.pos = 0
+			}
+		}
+
+		// The last click is a landing, which is counted below
+		if click < count && slices.Contains(r.targets, r.pos) {
+			m := r.counts[r.pos]
+			m.Passed++
+			r.counts[r.pos] = m
+			event.Passes++
+		}
+	}
+
+	if slices.Contains(r.targets, r.pos) {
+		m := r.counts[r.pos]
+		m.Landed++
+		r.counts[r.pos] = m
+		event.Landed = true
+	}
+
+	event.End = r.pos
+
+	return event
+}
+
+// Random dials and rotations have to agree with the click-by-click reference. The seed is fixed, so a
+// failure can be reproduced.
+func TestDialAgainstReference(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 0))
+
+	for trial := range 2000 {
+		if err := checkRandomDial(rng); err != nil {
+			t.Fatalf("trial %d: %v", trial+1, err)
+		}
+	}
+}
+
+func checkRandomDial(rng *rand.Rand) error {
+	// Mostly small dials, so targets get hit often, with the occasional big one
+	size := 1 + rng.IntN(12)
+	if rng.IntN(4) == 0 {
+		size = 1 + rng.IntN(1000)
+	}
+
+	start := rng.IntN(size)
+	targets := make([]int, 1+rng.IntN(4))
+	for i := range targets {
+		targets[i] = rng.IntN(size)
+	}
+
+	desc := fmt.Sprintf("dial of size %d starting at %d with targets %v", size, start, targets)
+
+	dial, err := NewDial(size, start, targets...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", desc, err)
+	}
+
+	ref := newReferenceDial(size, start, targets)
+
+	for range 1 + rng.IntN(30) {
+		dir := 'L'
+		if rng.IntN(2) == 0 {
+			dir = 'R'
+		}
+
+		count := randomDistance(rng, size)
+
+		// A negative distance has to be turned away without moving the dial or touching the counts
+		if count < 0 {
+			before := dial.Position()
+
+			if _, err := dial.Turn(dir, count); err == nil {
+				return fmt.Errorf("%s: %c%d was accepted", desc, dir, count)
+			}
+
+			if dial.Position() != before {
+				return fmt.Errorf("%s: rejected %c%d still moved the dial from %d to %d", desc, dir, count, before, dial.Position())
+			}
+
+			continue
+		}
+
+		got, err := dial.Turn(dir, count)
+		if err != nil {
+			return fmt.Errorf("%s: %c%d: %w", desc, dir, count, err)
+		}
+
+		want := ref.turn(dir, count)
+
+		if got != want {
+			return fmt.Errorf("%s: %c%d gave %+v, but clicking through it gives %+v", desc, dir, count, got, want)
+		}
+
+		if err := checkTurnProperties(size, targets, got); err != nil {
+			return fmt.Errorf("%s: %c%d: %w", desc, dir, count, err)
+		}
+	}
+
+	for _, t := range targets {
+		if got, want := dial.Marks(t), ref.counts[t]; got != want {
+			return fmt.Errorf("%s: target %d has %+v, but clicking through gives %+v", desc, t, got, want)
+		}
+	}
+
+	return nil
+}
+
+// Distances cover no movement, a little, whole and nearly whole laps, thousands of clicks, and the odd
+// negative that should be rejected
+func randomDistance(rng *rand.Rand, size int) int {
+	switch rng.IntN(8) {
+	case 0:
+		return 0
+	case 1:
+		return -1 - rng.IntN(200)
+	case 2:
+		return size*(1+rng.IntN(5)) + rng.IntN(3) - 1
+	case 3:
+		return 1000 + rng.IntN(9000)
+	}
+
+	return rng.IntN(2 * size)
+}
+
+// Facts about a single rotation that hold regardless of how it was worked out
+func checkTurnProperties(size int, targets []int, e Event) error {
+	step := 1
+	if e.Direction == "L" {
+		step = -1
+	}
+
+	if e.End != mod(e.Start+step*e.Distance, size) {
+		return fmt.Errorf("ended at %d, which is not %d clicks from %d", e.End, e.Distance, e.Start)
+	}
+
+	if e.Landed != slices.Contains(targets, e.End) {
+		return fmt.Errorf("landed is %v, but the dial ended at %d", e.Landed, e.End)
+	}
+
+	// Each full lap reaches every target once and the partial lap at the end reaches each at most once more.
+	// Only the final click is a landing rather than a pass.
+	unique := map[int]bool{}
+	for _, t := range targets {
+		unique[t] = true
+	}
+
+	laps := e.Distance / size
+	if e.Passes < laps*len(unique)-1 || e.Passes > (laps+1)*len(unique) {
+		return fmt.Errorf("%d passes is impossible over %d full laps of a dial with %d targets", e.Passes, laps, len(unique))
+	}
+
+	if e.Distance == 0 && e.Passes != 0 {
+		return fmt.Errorf("%d passes without moving", e.Passes)
+	}
+
+	return nil
+}
+
+// turn is the puzzle's dial, so it has to match the reference on a dial of 100 watching 0
+func TestTurnAgainstReference(t *testing.T) {
+	rng := rand.New(rand.NewPCG(2, 0))
+
+	for range 2000 {
+		pos, count := rng.IntN(100), randomDistance(rng, 100)
+		if count < 0 {
+			continue
+		}
+
+		dir := 'L'
+		if rng.IntN(2) == 0 {
+			dir = 'R'
+		}
+
+		end, passes, err := turn(pos, dir, count)
+		if err != nil {
+			t.Fatalf("%c%d from %d: %v", dir, count, pos, err)
+		}
+
+		want := newReferenceDial(100, pos, []int{0}).turn(dir, count)
+
+		if end != want.End || passes != want.Passes {
+			t.Fatalf("%c%d from %d ended at %d with %d passes, but clicking through gives %d with %d", dir, count, pos, end, passes, want.End, want.Passes)
+		}
+	}
+}
+
+// Exact laps that start on the target are where the old arithmetic went wrong, so they're spelled out
+func TestExactLaps(t *testing.T) {
+	tests := []struct {
+		size, start int
+		targets     []int
+		dir         rune
+		count       int
+		landed      bool
+		passes      int
+	}{
+		{100, 0, []int{0}, 'R', 100, true, 0},
+		{100, 0, []int{0}, 'L', 100, true, 0},
+		{100, 0, []int{0}, 'L', 200, true, 1},
+		{100, 0, []int{0}, 'R', 300, true, 2},
+		{100, 0, []int{0}, 'R', 0, true, 0},
+		{100, 0, []int{0, 50}, 'R', 200, true, 3},
+		{100, 50, []int{0}, 'L', 100, false, 1},
+		{1, 0, []int{0}, 'R', 5, true, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%c%d from %d on a dial of %d watching %v", tt.dir, tt.count, tt.start, tt.size, tt.targets), func(t *testing.T) {
+			dial, err := NewDial(tt.size, tt.start, tt.targets...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := dial.Turn(tt.dir, tt.count)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.End != tt.start || got.Landed != tt.landed || got.Passes != tt.passes {
+				t.Errorf("got end %d, landed %v with %d passes; want %d, %v with %d", got.End, got.Landed, got.Passes, tt.start, tt.landed, tt.passes)
+			}
+
+			if want := newReferenceDial(tt.size, tt.start, tt.targets).turn(tt.dir, tt.count); got != want {
+				t.Errorf("got %+v, but clicking through gives %+v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package day01
+
+import "fmt"
+
+// Rotation is a single turn of a dial
+type Rotation struct {
+	Dir   rune
+	Count int
+}
+
+// String gives the rotation in the puzzle's input format, ie L68
+func (r Rotation) String() string {
+	return fmt.Sprintf("%c%d", r.Dir, r.Count)
+}
+
+// FindRotations works backwards from a password: it returns the shortest list of rotations that takes a dial
+// of the given size from start to landing on target part1 times, while reaching it part2 times in all
+// (landings plus passes, as in part 2 of the puzzle). Among the shortest lists, it picks one with the fewest
+// clicks.
+//
+// Every landing needs a rotation of its own, while passes only cost clicks, as any rotation can take in extra
+// full laps. A rotation of no clicks lands without passing anything if the dial is already on the target. So
+// the answer is a first rotation that reaches the target with all of the passes loaded onto it, then R0 for
+// each of the other landings. A dial that starts on the target and has no passes to make needs nothing but R0.
+// Without any landings, a single rotation steps one click past the target after its last pass.
+func FindRotations(size, start, target, part1, part2 int) ([]Rotation, error) {
+	switch {
+	case size < 1:
+		return nil, fmt.Errorf("invalid dial size %d", size)
+	case start < 0 || start >= size:
+		return nil, fmt.Errorf("start position %d is off a dial of size %d", start, size)
+	case target < 0 || target >= size:
+		return nil, fmt.Errorf("target position %d is off a dial of size %d", target, size)
+	case part1 < 0 || part2 < part1:
+		return nil, fmt.Errorf("part 2 counts every time part 1 does, so %d and %d can't both happen", part1, part2)
+	}
+
+	if part2 == 0 {
+		return []Rotation{}, nil
+	}
+
+	// Every click of a single position dial lands on the target, so there's no stepping past it
+	if part1 == 0 && size == 1 {
+		return nil, fmt.Errorf("a dial of size 1 can't pass its target without landing on it")
+	}
+
+	// Clicks to the first time the target comes around, going each way. A dial already on the target
+	// needs a full lap.
+	right, left := mod(target-start, size), mod(start-target, size)
+	if right == 0 {
+		right, left = size, size
+	}
+
+	dir, first := 'R', right
+	if left < right {
+		dir, first = 'L', left
+	}
+
+	if part1 == 0 {
+		// Reach the target part2 times, then go one more click so the rotation doesn't finish on it
+		return []Rotation{{dir, first + (part2-1)*size + 1}}, nil
+	}
+
+	rotations := make([]Rotation, part1)
+
+	for i := range rotations {
+		rotations[i] = Rotation{'R', 0}
+	}
+
+	if start != target || part2 > part1 {
+		rotations[0] = Rotation{dir, first + (part2-part1)*size}
+	}
+
+	return rotations, nil
+}
@@ -0,0 +1,216 @@
+package day01
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestFindRotations(t *testing.T) {
+	tests := []struct {
+		size, start, target int
+		part1, part2        int
+		want                []Rotation
+	}{
+		{100, 50, 0, 0, 0, []Rotation{}},
+		{100, 50, 0, 3, 3, []Rotation{{'R', 50}, {'R', 0}, {'R', 0}}},
+		{100, 50, 0, 3, 6, []Rotation{{'R', 350}, {'R', 0}, {'R', 0}}},
+		{100, 30, 0, 1, 1, []Rotation{{'L', 30}}},
+		{100, 50, 0, 0, 2, []Rotation{{'R', 151}}},
+		{100, 0, 0, 2, 2, []Rotation{{'R', 0}, {'R', 0}}},
+		{100, 0, 0, 1, 3, []Rotation{{'R', 300}}},
+		{100, 0, 0, 0, 1, []Rotation{{'R', 101}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("size %d from %d to %d, part 1 %d, part 2 %d", tt.size, tt.start, tt.target, tt.part1, tt.part2), func(t *testing.T) {
+			got, err := FindRotations(tt.size, tt.start, tt.target, tt.part1, tt.part2)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindRotationsImpossible(t *testing.T) {
+	tests := []struct {
+		name                string
+		size, start, target int
+		part1, part2        int
+	}{
+		{"no size", 0, 0, 0, 1, 1},
+		{"start off the dial", 10, 10, 0, 1, 1},
+		{"target off the dial", 10, 0, -1, 1, 1},
+		{"more landings than reaches", 10, 0, 0, 2, 1},
+		{"passes on a dial of size 1", 1, 0, 0, 0, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := FindRotations(tt.size, tt.start, tt.target, tt.part1, tt.part2); err == nil {
+				t.Errorf("got %v for an impossible request", got)
+			}
+		})
+	}
+}
+
+// Random requests have to replay to the requested counts, on a Dial, the click-by-click reference and (for
+// the puzzle's own dial) the solver. Small ones are checked against every list of rotations of the same
+// length, so the clicks are known to be as few as they can be.
+func TestFindRotationsReplay(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 0))
+
+	for trial := range 1000 {
+		size := 1 + rng.IntN(12)
+		if rng.IntN(4) == 0 {
+			size = 100
+		}
+
+		start, target := rng.IntN(size), rng.IntN(size)
+		part1 := rng.IntN(6)
+		part2 := part1 + rng.IntN(20)
+		if rng.IntN(4) == 0 {
+			part1 = 0
+		}
+
+		desc := fmt.Sprintf("trial %d: dial of size %d from %d to %d, part 1 %d, part 2 %d", trial+1, size, start, target, part1, part2)
+
+		rotations, err := FindRotations(size, start, target, part1, part2)
+		if part1 == 0 && part2 > 0 && size == 1 {
+			if err == nil {
+				t.Fatalf("%s: got %v for an impossible request", desc, rotations)
+			}
+
+			continue
+		} else if err != nil {
+			t.Fatalf("%s: %v", desc, err)
+		}
+
+		// Every landing takes a rotation, and any passes without a landing take one more
+		shortest := part1
+		if part1 == 0 && part2 > 0 {
+			shortest = 1
+		}
+
+		if len(rotations) != shortest {
+			t.Fatalf("%s: got %d rotations, but %d is enough", desc, len(rotations), shortest)
+		}
+
+		dial, err := NewDial(size, start, target)
+		if err != nil {
+			t.Fatalf("%s: %v", desc, err)
+		}
+
+		ref := newReferenceDial(size, start, []int{target})
+
+		for _, r := range rotations {
+			if _, err := dial.Turn(r.Dir, r.Count); err != nil {
+				t.Fatalf("%s: %v: %v", desc, r, err)
+			}
+
+			ref.turn(r.Dir, r.Count)
+		}
+
+		for _, m := range []Marks{dial.Marks(target), ref.counts[target]} {
+			if m.Landed != part1 || m.Total() != part2 {
+				t.Fatalf("%s: %v gives part 1 %d and part 2 %d", desc, rotations, m.Landed, m.Total())
+			}
+		}
+
+		// The puzzle's own dial can run it through the solver too
+		if size == defaultSize && start == defaultStart && target == 0 {
+			if err := checkWithSolver(rotations, part1, part2); err != nil {
+				t.Fatalf("%s: %v", desc, err)
+			}
+		}
+
+		if size <= 4 && part2 <= part1+3 && shortest <= 3 {
+			if want := fewestClicks(size, start, target, part1, part2, shortest); clicks(rotations) != want {
+				t.Fatalf("%s: %v takes %d clicks, but %d is enough", desc, rotations, clicks(rotations), want)
+			}
+		}
+	}
+}
+
+func clicks(rotations []Rotation) int {
+	total := 0
+
+	for _, r := range rotations {
+		total += r.Count
+	}
+
+	return total
+}
+
+// The fewest clicks over every list of n rotations giving the counts, found by trying them all. No rotation
+// needs more than a lap past the last reach, so that's as far as it looks.
+func fewestClicks(size, start, target, part1, part2, n int) int {
+	best := -1
+	longest := (part2+1)*size + 1
+
+	var try func(pos, landed, reached, left, clicks int)
+	try = func(pos, landed, reached, left, clicks int) {
+		if landed > part1 || reached > part2 || (best >= 0 && clicks >= best) {
+			return
+		}
+
+		if left == 0 {
+			if landed == part1 && reached == part2 {
+				best = clicks
+			}
+
+			return
+		}
+
+		for _, dir := range []rune{'L', 'R'} {
+			for count := range longest + 1 {
+				dial, _ := NewDial(size, pos, target)
+				event, _ := dial.Turn(dir, count)
+
+				landings := 0
+				if event.Landed {
+					landings = 1
+				}
+
+				try(event.End, landed+landings, reached+landings+event.Passes, left-1, clicks+count)
+			}
+		}
+	}
+
+	try(start, 0, 0, n, 0)
+
+	return best
+}
+
+func checkWithSolver(rotations []Rotation, part1, part2 int) error {
+	lines := make([]string, len(rotations))
+	for i, r := range rotations {
+		lines[i] = r.String()
+	}
+
+	s := &Solver{}
+	if err := s.Parse(lines); err != nil {
+		return err
+	}
+
+	got1, err := s.Part1()
+	if err != nil {
+		return err
+	}
+
+	got2, err := s.Part2()
+	if err != nil {
+		return err
+	}
+
+	if got1 != part1 || got2 != part2 {
+		return fmt.Errorf("solving %v gives part 1 %v and part 2 %v", lines, got1, got2)
+	}
+
+	return nil
+}
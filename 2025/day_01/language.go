@@ -0,0 +1,308 @@
+package day01
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/digdon/2025aoc/input"
+)
+
+// Besides the puzzle's plain L<count> and R<count> lines, the parser understands a small language for
+// driving several dials at once:
+//
+//	# comments run from a # to the end of the line
+//	dial left 100 50     declare a dial with its size and starting position
+//	left R20             turn a named dial; plain rotations turn the puzzle's dial
+//	left,right L5        turn several dials together
+//	right =37            set a dial to a position, without it counting as a rotation
+//	repeat 3 {           repeat the block, which can be nested
+//	  L10
+//	}
+//
+// Dials that are used without being declared have the puzzle's size of 100 and start at 50.
+//
+// Repeats are run in full, so a few nested ones could ask for more work than would ever finish. Programs are
+// limited to maxSteps turns and sets once every repeat is expanded (with each pass through a repeat counting
+// as a step too, so empty ones can't get around it).
+
+const (
+	defaultSize  = 100
+	defaultStart = 50
+
+	// The dial that rotations without a name turn
+	puzzleDial = ""
+
+	maxSteps = 10_000_000
+)
+
+type op int
+
+const (
+	opTurn op = iota
+	opSet
+	opRepeat
+)
+
+type instruction struct {
+	line int
+	op   op
+
+	// Indexes of the dials to turn or set
+	dials []int
+	dir   rune
+
+	// Clicks to turn, the position to set, or how many times to repeat the body
+	count int
+	body  []instruction
+
+	// Steps it takes to run, with any repeats expanded (for an open repeat, the steps in one pass so far)
+	steps int
+}
+
+type dialSpec struct {
+	name        string
+	size, start int
+}
+
+var dialName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+const (
+	expectRotation = "L<count>, R<count> or =<position>, optionally after dial names"
+	expectDial     = "dial <name> <size> <start>"
+	expectRepeat   = "repeat <times> {"
+)
+
+type parser struct {
+	lines   []string
+	errs    input.Errors
+	dials   []dialSpec
+	known   map[string]int
+	program []instruction
+	steps   int
+
+	// Repeat blocks that are still open, innermost last
+	open []*instruction
+}
+
+func parseProgram(lines []string) ([]dialSpec, []instruction, error) {
+	p := &parser{lines: lines, known: map[string]int{}}
+
+	for i, line := range lines {
+		p.parseLine(i+1, line)
+	}
+
+	for _, block := range p.open {
+		p.errs.Add(block.line, lines[block.line-1], "}", fmt.Errorf("repeat block is never closed"))
+	}
+
+	return p.dials, p.program, p.errs.Err()
+}
+
+func (p *parser) parseLine(lineNo int, line string) {
+	text, _, _ := strings.Cut(line, "#")
+	fields := strings.Fields(text)
+
+	switch {
+	case len(fields) == 0:
+		// Blank, or only a comment
+
+	case fields[0] == "dial":
+		if err := p.declare(fields); err != nil {
+			p.errs.Add(lineNo, line, expectDial, err)
+		}
+
+	case fields[0] == "repeat":
+		times, err := parseRepeat(fields)
+		if err != nil {
+			p.errs.Add(lineNo, line, expectRepeat, err)
+
+			// Still open a block, so the closing brace has something to match
+			times = 1
+		}
+
+		p.open = append(p.open, &instruction{line: lineNo, op: opRepeat, count: times})
+
+	case fields[0] == "}":
+		if len(fields) != 1 || len(p.open) == 0 {
+			p.errs.Add(lineNo, line, "a } closing a repeat block", fmt.Errorf("nothing to close"))
+			return
+		}
+
+		block := p.open[len(p.open)-1]
+		p.open = p.open[:len(p.open)-1]
+
+		// Each pass runs the body, plus a step for the pass itself
+		if block.count > 0 && block.steps+1 > maxSteps/block.count {
+			p.errs.Add(block.line, p.lines[block.line-1], expectRepeat, fmt.Errorf("repeats to more than %d steps", maxSteps))
+			return
+		}
+
+		block.steps = block.count * (block.steps + 1)
+		p.add(*block)
+
+	default:
+		inst, err := p.parseRotation(lineNo, fields)
+		if err != nil {
+			p.errs.Add(lineNo, line, expectRotation, err)
+			return
+		}
+
+		p.add(inst)
+	}
+}
+
+// Adds an instruction to the innermost open block, or the program itself
+func (p *parser) add(inst instruction) {
+	steps := &p.steps
+	if len(p.open) > 0 {
+		steps = &p.open[len(p.open)-1].steps
+	}
+
+	if *steps+inst.steps > maxSteps {
+		expect := expectRotation
+		if inst.op == opRepeat {
+			expect = expectRepeat
+		}
+
+		p.errs.Add(inst.line, p.lines[inst.line-1], expect, fmt.Errorf("takes the program past %d steps", maxSteps))
+		return
+	}
+
+	*steps += inst.steps
+
+	if len(p.open) == 0 {
+		p.program = append(p.program, inst)
+		return
+	}
+
+	block := p.open[len(p.open)-1]
+	block.body = append(block.body, inst)
+}
+
+func (p *parser) declare(fields []string) error {
+	if len(fields) != 4 {
+		return fmt.Errorf("wrong number of fields")
+	}
+
+	name := fields[1]
+	if err := checkName(name); err != nil {
+		return err
+	}
+
+	if _, found := p.known[name]; found {
+		return fmt.Errorf("dial %s is already in use", name)
+	}
+
+	size, err := strconv.Atoi(fields[2])
+	if err != nil || size < 1 {
+		return fmt.Errorf("invalid size %q", fields[2])
+	}
+
+	start, err := strconv.Atoi(fields[3])
+	if err != nil || start < 0 || start >= size {
+		return fmt.Errorf("invalid start %q for a dial of size %d", fields[3], size)
+	}
+
+	p.known[name] = len(p.dials)
+	p.dials = append(p.dials, dialSpec{name: name, size: size, start: start})
+
+	return nil
+}
+
+func parseRepeat(fields []string) (int, error) {
+	if len(fields) != 3 || fields[2] != "{" {
+		return 0, fmt.Errorf("wrong number of fields")
+	}
+
+	times, err := strconv.Atoi(fields[1])
+	if err != nil || times < 0 {
+		return 0, fmt.Errorf("invalid repeat count %q", fields[1])
+	}
+
+	return times, nil
+}
+
+func (p *parser) parseRotation(lineNo int, fields []string) (instruction, error) {
+	inst := instruction{line: lineNo}
+	names, word := []string{puzzleDial}, fields[0]
+
+	switch len(fields) {
+	case 1:
+	case 2:
+		names, word = strings.Split(fields[0], ","), fields[1]
+
+		for _, name := range names {
+			if err := checkName(name); err != nil {
+				return inst, err
+			}
+		}
+	default:
+		return inst, fmt.Errorf("wrong number of fields")
+	}
+
+	if len(word) < 2 {
+		return inst, fmt.Errorf("failed to parse rotation")
+	}
+
+	count, err := strconv.Atoi(word[1:])
+	if err != nil {
+		return inst, fmt.Errorf("failed to parse rotation")
+	}
+
+	switch word[0] {
+	case 'L', 'R':
+		if count < 0 {
+			return inst, fmt.Errorf("negative count %d", count)
+		}
+
+		inst.op, inst.dir = opTurn, rune(word[0])
+	case '=':
+		inst.op = opSet
+	default:
+		return inst, fmt.Errorf("unknown direction %q", word[0])
+	}
+
+	inst.count, inst.steps = count, len(names)
+
+	for _, name := range names {
+		i := p.use(name)
+
+		if size := p.dials[i].size; inst.op == opSet && (count < 0 || count >= size) {
+			return inst, fmt.Errorf("position %d is off %s, which has size %d", count, describeDial(name), size)
+		}
+
+		inst.dials = append(inst.dials, i)
+	}
+
+	return inst, nil
+}
+
+// Looks up a dial's index, setting it up with the puzzle's size and start the first time it's seen
+func (p *parser) use(name string) int {
+	if i, found := p.known[name]; found {
+		return i
+	}
+
+	p.known[name] = len(p.dials)
+	p.dials = append(p.dials, dialSpec{name: name, size: defaultSize, start: defaultStart})
+
+	return len(p.dials) - 1
+}
+
+func checkName(name string) error {
+	if !dialName.MatchString(name) || name == "dial" || name == "repeat" {
+		return fmt.Errorf("invalid dial name %q", name)
+	}
+
+	return nil
+}
+
+func describeDial(name string) string {
+	if name == puzzleDial {
+		return "the dial"
+	}
+
+	return "dial " + name
+}
@@ -0,0 +1,91 @@
+package day02
+
+import (
+	_ "embed"
+	"strconv"
+	"strings"
+
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/interval"
+	"github.com/digdon/2025aoc/solver"
+	"github.com/digdon/2025aoc/trace"
+)
+
+//go:embed example.txt
+var example string
+
+var tracer = trace.For(2025, 2)
+
+func init() {
+	solver.Register(2025, 2, func() solver.Solver { return &Solver{} })
+	solver.RegisterExamples(2025, 2, solver.Example{Input: example, Part1: 1227775554, Part2: 4174379265})
+}
+
+type Solver struct {
+	ranges []interval.Interval
+}
+
+func (s *Solver) Parse(lines []string) error {
+	var errs input.Errors
+
+	for i, line := range lines {
+		ranges, err := input.Ranges(line)
+		if err != nil {
+			errs.Add(i+1, line, "comma-separated start-end ranges", err)
+			continue
+		}
+
+		s.ranges = append(s.ranges, ranges...)
+	}
+
+	return errs.Err()
+}
+
+func (s *Solver) Part1() (any, error) {
+	total := 0
+
+	for _, r := range s.ranges {
+		for i := r.Start; i <= r.End; i++ {
+			str := strconv.Itoa(i)
+			halfLen := len(str) / 2
+
+			if len(str)%2 == 0 {
+				if str[0:halfLen] == str[halfLen:] {
+					tracer.Debug("invalid ID", "part", 1, "id", i)
+					total += i
+				}
+			}
+		}
+	}
+
+	return total, nil
+}
+
+func (s *Solver) Part2() (any, error) {
+	total := 0
+
+	for _, r := range s.ranges {
+		for i := r.Start; i <= r.End; i++ {
+			str := strconv.Itoa(i)
+			fullLen, halfLen := len(str), len(str)/2
+
+			for j := range halfLen {
+				if fullLen%(j+1) != 0 {
+					// Skip over anything we know can't match based on length
+					continue
+				}
+
+				// Build comparison string
+				re := strings.Repeat(str[:j+1], fullLen/(j+1))
+
+				if re == str {
+					tracer.Debug("invalid ID", "part", 2, "id", i, "repeats", fullLen/(j+1))
+					total += i
+					break
+				}
+			}
+		}
+	}
+
+	return total, nil
+}
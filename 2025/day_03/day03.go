@@ -0,0 +1,157 @@
+package day03
+
+import (
+	_ "embed"
+	"fmt"
+
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/solver"
+)
+
+//go:embed example.txt
+var example string
+
+func init() {
+	solver.Register(2025, 3, func() solver.Solver { return &Solver{} })
+	solver.RegisterExamples(2025, 3, solver.Example{Input: example, Part1: 357, Part2: 3121910778619})
+}
+
+type Solver struct {
+	batteryBanks [][]int
+}
+
+func (s *Solver) Parse(lines []string) error {
+	var errs input.Errors
+
+	for i, line := range lines {
+		var bank []int
+
+		for j, char := range line {
+			if char < '0' || char > '9' {
+				errs.Add(i+1, line, "a row of digits", &input.Error{Col: j + 1, Err: fmt.Errorf("invalid joltage %q", char)})
+				bank = nil
+				break
+			}
+
+			bank = append(bank, int(char-'0'))
+		}
+
+		if bank != nil {
+			s.batteryBanks = append(s.batteryBanks, bank)
+		}
+	}
+
+	return errs.Err()
+}
+
+func (s *Solver) Part1() (any, error) {
+	return processBanks(s.batteryBanks, 2, processNonRecursion), nil
+}
+
+func (s *Solver) Part2() (any, error) {
+	return processBanks(s.batteryBanks, 12, processNonRecursion), nil
+}
+
+// The earlier approaches, kept for benchmarking against the non-recursive version
+func (s *Solver) Variants() []solver.Variant {
+	return []solver.Variant{
+		{Name: "recursive", Part: 1, Solve: func() (any, error) { return processBanks(s.batteryBanks, 2, processRecursion), nil }},
+		{Name: "pairs", Part: 1, Solve: func() (any, error) { return partOne(s.batteryBanks), nil }},
+		{Name: "recursive", Part: 2, Solve: func() (any, error) { return processBanks(s.batteryBanks, 12, processRecursion), nil }},
+	}
+}
+
+func processBanks(batteryBanks [][]int, digitCount int, processBank func(bank []int, digitCount int) int) int {
+	totalJoltage := 0
+
+	for _, bank := range batteryBanks {
+		joltage := processBank(bank, digitCount)
+		totalJoltage += joltage
+	}
+
+	return totalJoltage
+}
+
+func processRecursion(bank []int, digitCount int) int {
+	return process(bank, 0, 0, digitCount)
+}
+
+func process(bank []int, currentJoltage int, pos int, digitCount int) int {
+	if digitCount == 0 {
+		// Collected enough digits - we're done
+		return currentJoltage
+	}
+
+	// From the starting position, find the highest number that still has enough remaining digits
+	var maxValue, maxPos int
+
+	for i := pos; i < len(bank)-digitCount+1; i++ {
+		if bank[i] > maxValue {
+			maxValue = bank[i]
+			maxPos = i
+
+			if maxValue == 9 {
+				// Can't do better than a 9, so stop looking
+				break
+			}
+		}
+	}
+
+	joltage := (currentJoltage * 10) + maxValue
+	return process(bank, joltage, maxPos+1, digitCount-1)
+}
+
+func processNonRecursion(bank []int, digitCount int) int {
+	startPos := 0
+	joltage := 0
+
+	for i := range digitCount {
+		// Find max from remaining digits, leaving enough room for the rest of the digits
+		var maxValue, maxPos int
+
+		for j := startPos; j < len(bank)-digitCount+i+1; j++ {
+			if bank[j] > maxValue {
+				maxValue = bank[j]
+				maxPos = j
+
+				if maxValue == 9 {
+					// Can't do better than a 9, so stop looking
+					break
+				}
+			}
+		}
+
+		joltage = (joltage * 10) + maxValue
+		startPos = maxPos + 1
+	}
+
+	return joltage
+}
+
+func partOne(batteryBanks [][]int) int {
+	var totalJoltage int
+
+	for _, bank := range batteryBanks {
+		var maxTens, maxJoltage int
+
+		for i := 0; i < len(bank)-1; i++ {
+			tens := bank[i] * 10
+			if tens <= maxTens {
+				continue
+			}
+
+			for j := i + 1; j < len(bank); j++ {
+				joltage := tens + bank[j]
+
+				if joltage > maxJoltage {
+					maxJoltage = joltage
+					maxTens = tens
+				}
+			}
+		}
+
+		totalJoltage += maxJoltage
+	}
+
+	return totalJoltage
+}
@@ -0,0 +1,158 @@
+package day04
+
+import (
+	_ "embed"
+	"fmt"
+	"image"
+
+	"github.com/digdon/2025aoc/export"
+	"github.com/digdon/2025aoc/grid"
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/solver"
+	"github.com/digdon/2025aoc/trace"
+	"github.com/digdon/2025aoc/visual"
+)
+
+//go:embed example.txt
+var example string
+
+var tracer = trace.For(2025, 4)
+
+func init() {
+	solver.Register(2025, 4, func() solver.Solver { return &Solver{} })
+	solver.RegisterExamples(2025, 4, solver.Example{Input: example, Part1: 13, Part2: 43})
+}
+
+type Solver struct {
+	rolls         *grid.Sparse[bool]
+	width, height int
+	sink          visual.Sink
+
+	// What's left after part 2, for the snapshot
+	remaining *grid.Sparse[bool]
+}
+
+func (s *Solver) Parse(lines []string) error {
+	var errs input.Errors
+	s.rolls = grid.NewSparse[bool]()
+
+	s.height = len(lines)
+
+	for y, line := range lines {
+		badLine := false
+		s.width = max(s.width, len(line))
+
+		for x, char := range line {
+			switch char {
+			case '@':
+				s.rolls.Set(grid.Point{X: x, Y: y}, true)
+			case '.':
+			default:
+				// Only report the first bad character on each line
+				if !badLine {
+					errs.Add(y+1, line, "only . and @", &input.Error{Col: x + 1, Err: fmt.Errorf("unexpected character %q", char)})
+					badLine = true
+				}
+			}
+		}
+	}
+
+	return errs.Err()
+}
+
+func (s *Solver) Part1() (any, error) {
+	return len(getMoveablePoints(s.rolls)), nil
+}
+
+func (s *Solver) Part2() (any, error) {
+	// Work on a copy, so that the parsed rolls are still intact for any later runs
+	rolls := s.rolls.Clone()
+	moveablePoints := getMoveablePoints(rolls)
+	totalMoved := 0
+	round := 0
+
+	for len(moveablePoints) > 0 {
+		totalMoved += len(moveablePoints)
+		round++
+		tracer.Debug("rolls removed", "round", round, "removed", len(moveablePoints), "remaining", rolls.Len()-len(moveablePoints))
+
+		if s.sink != nil {
+			s.sink(s.frame(fmt.Sprintf("Round %d: removing %d rolls", round, len(moveablePoints)), rolls, moveablePoints))
+		}
+
+		for _, p := range moveablePoints {
+			rolls.Delete(p)
+		}
+
+		moveablePoints = getMoveablePoints(rolls)
+	}
+
+	tracer.Info("removal finished", "rounds", round, "removed", totalMoved, "remaining", rolls.Len())
+
+	if s.sink != nil {
+		s.sink(s.frame(fmt.Sprintf("Done: %d rolls removed in %d rounds", totalMoved, round), rolls, nil))
+	}
+
+	s.remaining = rolls
+
+	return totalMoved, nil
+}
+
+func (s *Solver) Visualise(sink visual.Sink) {
+	s.sink = sink
+}
+
+// Draws the rolls left after part 2, or the starting rolls if it hasn't been run
+func (s *Solver) Snapshot() (image.Image, error) {
+	rolls := s.remaining
+	if rolls == nil {
+		rolls = s.rolls
+	}
+
+	return export.FromFrame(s.frame("", rolls, nil), 4), nil
+}
+
+// Draws the rolls that are left, highlighting the ones about to be removed. Spots that have already been
+// cleared are shown in green.
+func (s *Solver) frame(title string, rolls *grid.Sparse[bool], removing []grid.Point) visual.Frame {
+	f := visual.NewFrame(title, s.width, s.height)
+
+	for y := range s.height {
+		for x := range s.width {
+			p := grid.Point{X: x, Y: y}
+
+			switch {
+			case rolls.Has(p):
+				f.Set(p, '@', visual.Default)
+			case s.rolls.Has(p):
+				f.Set(p, '.', visual.Green)
+			default:
+				f.Set(p, '.', visual.Grey)
+			}
+		}
+	}
+
+	for _, p := range removing {
+		f.Set(p, '@', visual.Red)
+	}
+
+	return f
+}
+
+func getMoveablePoints(rolls *grid.Sparse[bool]) []grid.Point {
+	moveablePoints := []grid.Point{}
+
+	for p := range rolls.All() {
+		rollcount := 0
+
+		for range rolls.Neighbours8(p) {
+			rollcount++
+		}
+
+		if rollcount < 4 {
+			moveablePoints = append(moveablePoints, p)
+		}
+	}
+
+	return moveablePoints
+}
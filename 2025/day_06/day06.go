@@ -0,0 +1,192 @@
+package day06
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/interval"
+	"github.com/digdon/2025aoc/solver"
+)
+
+//go:embed example.txt
+var example string
+
+func init() {
+	solver.Register(2025, 6, func() solver.Solver { return &Solver{} })
+	solver.RegisterExamples(2025, 6, solver.Example{Input: example, Part1: 4277556, Part2: 3263827})
+}
+
+type Solver struct {
+	// Part 1 reads the worksheet as rows of whitespace-separated numbers
+	grid       [][]int
+	operations []rune
+
+	// Part 2 reads the worksheet column by column, so it works from the (good) raw lines
+	inputLines []string
+}
+
+func (s *Solver) Parse(lines []string) error {
+	var errs input.Errors
+
+	if len(lines) < 2 {
+		return errors.New("expected at least one row of numbers followed by a row of operations")
+	}
+
+	// The last line holds the operations, one per column
+	opLine := lines[len(lines)-1]
+
+	for _, value := range strings.Fields(opLine) {
+		// Without the operations there's nothing to work out, so this isn't a line that can be skipped
+		if value != "*" && value != "+" {
+			return &input.Error{Line: len(lines), Text: opLine, Expected: "* or + for each column", Err: fmt.Errorf("invalid operation %q", value)}
+		}
+
+		s.operations = append(s.operations, rune(value[0]))
+	}
+
+	// Everything above it is rows of numbers
+	width := len(opLine)
+
+	for i, line := range lines[:len(lines)-1] {
+		fields := strings.Fields(line)
+
+		if len(fields) != len(s.operations) {
+			errs.Add(i+1, line, fmt.Sprintf("%d numbers", len(s.operations)), fmt.Errorf("found %d values", len(fields)))
+			continue
+		}
+
+		row := []int{}
+
+		for _, value := range fields {
+			value, err := strconv.Atoi(value)
+			if err != nil {
+				errs.Add(i+1, line, "whitespace-separated numbers", errors.New("error parsing grid value"))
+				row = nil
+				break
+			}
+			row = append(row, value)
+		}
+
+		if row != nil {
+			s.grid = append(s.grid, row)
+			s.inputLines = append(s.inputLines, line)
+			width = max(width, len(line))
+		}
+	}
+
+	s.inputLines = append(s.inputLines, opLine)
+
+	// Pad every line out to the same width, so that part 2 can read the columns without worrying about
+	// trimmed trailing spaces
+	for i, line := range s.inputLines {
+		s.inputLines[i] = line + strings.Repeat(" ", width-len(line))
+	}
+
+	return errs.Err()
+}
+
+func (s *Solver) Part1() (any, error) {
+	return part1(s.grid, s.operations), nil
+}
+
+func (s *Solver) Part2() (any, error) {
+	return part2(s.inputLines), nil
+}
+
+func part1(grid [][]int, operations []rune) int {
+	colValues := []int{}
+
+	for col, op := range operations {
+		var value int
+
+		if op == '*' {
+			value = 1
+		}
+
+		for _, row := range grid {
+			switch op {
+			case '*':
+				value *= row[col]
+			case '+':
+				value += row[col]
+			}
+		}
+
+		colValues = append(colValues, value)
+	}
+
+	total := 0
+
+	for _, v := range colValues {
+		total += v
+	}
+
+	return total
+}
+
+func part2(inputLines []string) int {
+	// Start by finding the start and end positions of each column, based on location of the operations
+	colRanges := []interval.Interval{}
+	operations := []rune{}
+	start := len(inputLines[len(inputLines)-1]) - 1
+	end := start
+	line := inputLines[len(inputLines)-1]
+	for pos := start; pos >= 0; pos-- {
+		if line[pos] != ' ' {
+			operations = append(operations, rune(line[pos]))
+			colRanges = append(colRanges, interval.Interval{Start: pos, End: end})
+			end = pos - 2
+		}
+	}
+
+	maxDigits := len(inputLines) - 1
+	grandTotal := 0
+
+	// Now we process each column range. For each column, we extract the digits from each row,
+	// build the numbers, then apply the operation to get the column total. Finally, we sum
+	// all the column totals to get the grand total.
+	for i, cr := range colRanges {
+		colValues := []int{}
+
+		for col := cr.Start; col <= cr.End; col++ {
+			value := 0
+
+			for row := range maxDigits {
+				char := inputLines[row][col]
+
+				if char == ' ' {
+					continue
+				}
+
+				digit := int(char - '0')
+				value = value*10 + digit
+			}
+
+			colValues = append(colValues, value)
+		}
+
+		op := operations[i]
+		colTotal := 0
+
+		if op == '*' {
+			colTotal = 1
+		}
+
+		for _, v := range colValues {
+			switch op {
+			case '*':
+				colTotal *= v
+			case '+':
+				colTotal += v
+			}
+		}
+
+		grandTotal += colTotal
+	}
+
+	return grandTotal
+}
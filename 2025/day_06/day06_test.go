@@ -0,0 +1,23 @@
+package day06
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/solver"
+	"github.com/digdon/2025aoc/solver/solvertest"
+)
+
+func FuzzParse(f *testing.F) {
+	solvertest.FuzzParse(f, 2025, 6, func() solver.Solver { return &Solver{} })
+}
+
+// A bad operations row leaves nothing to solve, so it mustn't look like a line that -skip-bad can skip
+func TestBadOperations(t *testing.T) {
+	err := (&Solver{}).Parse([]string{"123 328", " 45 64 ", "@*   +"})
+
+	if err == nil || errors.As(err, new(input.Errors)) {
+		t.Errorf("got error %v, want one that can't be skipped", err)
+	}
+}
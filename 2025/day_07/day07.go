@@ -0,0 +1,163 @@
+package day07
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"image"
+	"log/slog"
+
+	"github.com/digdon/2025aoc/export"
+	"github.com/digdon/2025aoc/grid"
+	"github.com/digdon/2025aoc/solver"
+	"github.com/digdon/2025aoc/trace"
+	"github.com/digdon/2025aoc/visual"
+)
+
+//go:embed example.txt
+var example string
+
+var tracer = trace.For(2025, 7)
+
+func init() {
+	solver.Register(2025, 7, func() solver.Solver { return &Solver{} })
+	solver.RegisterExamples(2025, 7, solver.Example{Input: example, Part1: 21, Part2: 40})
+}
+
+type Solver struct {
+	manifold *grid.Dense[byte]
+	start    grid.Point
+	sink     visual.Sink
+
+	// The beam counts from the last trace, for the snapshot
+	beams *grid.Dense[int]
+}
+
+func (s *Solver) Parse(lines []string) error {
+	manifold, err := grid.Parse(lines)
+	if err != nil {
+		return err
+	}
+
+	// Find the start position
+	start, found := manifold.Find(func(char byte) bool { return char == 'S' })
+	if !found {
+		return errors.New("no start position (S) in the manifold")
+	}
+
+	s.manifold = manifold
+	s.start = start
+
+	return nil
+}
+
+func (s *Solver) Part1() (any, error) {
+	splitterCount, _ := s.traceBeams()
+	return splitterCount, nil
+}
+
+func (s *Solver) Part2() (any, error) {
+	_, pathCount := s.traceBeams()
+	return pathCount, nil
+}
+
+func (s *Solver) Visualise(sink visual.Sink) {
+	s.sink = sink
+}
+
+// A heatmap of the number of timelines passing through each spot
+func (s *Solver) Snapshot() (image.Image, error) {
+	if s.beams == nil {
+		s.traceBeams()
+	}
+
+	return export.Heatmap(s.beams, 4), nil
+}
+
+// Draws the manifold with the beams traced so far, down to the given row. Splitters that have been hit are
+// yellow, and the rest are grey.
+func (s *Solver) frame(title string, beams *grid.Dense[int], row int) visual.Frame {
+	f := visual.NewFrame(title, s.manifold.Width, s.manifold.Height)
+
+	for p, char := range s.manifold.All() {
+		switch {
+		case char == 'S':
+			f.Set(p, 'S', visual.Green)
+		case char == '^' && p.Y <= row && beams.Get(grid.Point{X: p.X, Y: p.Y - 1}) > 0:
+			f.Set(p, '^', visual.Yellow)
+		case char == '^':
+			f.Set(p, '^', visual.Grey)
+		case p.Y <= row && beams.Get(p) > 0:
+			f.Set(p, '|', visual.Cyan)
+		default:
+			f.Set(p, '.', visual.Grey)
+		}
+	}
+
+	return f
+}
+
+// Sends the beam down through the manifold, returning the number of splitters hit and the number
+// of paths (timelines) that make it to the bottom row
+func (s *Solver) traceBeams() (int, int) {
+	manifold := s.manifold
+
+	// Place the first beam, directly below the start
+	beams := grid.NewDense[int](manifold.Width, manifold.Height)
+	beams.Set(grid.Point{X: s.start.X, Y: s.start.Y + 1}, 1)
+	splitterCount := 0
+
+	// Now we start moving down the grid, starting from the row below the first beam
+	for y := s.start.Y + 2; y < manifold.Height; y++ {
+		for x := range manifold.Width {
+			p := grid.Point{X: x, Y: y}
+			above := beams.Get(grid.Point{X: x, Y: y - 1})
+
+			if above == 0 {
+				continue
+			}
+
+			switch manifold.Get(p) {
+			case '.':
+				// There's a beam directly above, so it continues down
+				beams.Set(p, beams.Get(p)+above)
+			case '^':
+				// Found a splitter with a beam above it, so split it left and right
+				splitterCount++
+
+				for _, side := range []grid.Point{{X: x - 1, Y: y}, {X: x + 1, Y: y}} {
+					if manifold.Get(side) == '.' {
+						beams.Set(side, beams.Get(side)+above)
+					}
+				}
+			}
+		}
+
+		if tracer.Enabled(context.Background(), slog.LevelDebug) {
+			beamCount, timelines := 0, 0
+			for x := range beams.Width {
+				if paths := beams.Get(grid.Point{X: x, Y: y}); paths > 0 {
+					beamCount++
+					timelines += paths
+				}
+			}
+
+			tracer.Debug("row swept", "row", y, "beams", beamCount, "timelines", timelines, "splitters", splitterCount)
+		}
+
+		if s.sink != nil {
+			s.sink(s.frame(fmt.Sprintf("Row %d of %d: %d splitters hit", y, manifold.Height-1, splitterCount), beams, y))
+		}
+	}
+
+	pathCount := 0
+	for x := range beams.Width {
+		pathCount += beams.Get(grid.Point{X: x, Y: beams.Height - 1})
+	}
+
+	s.beams = beams
+	tracer.Info("beams traced", "rows", manifold.Height, "splitters", splitterCount, "timelines", pathCount)
+
+	return splitterCount, pathCount
+}
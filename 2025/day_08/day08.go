@@ -0,0 +1,247 @@
+package day08
+
+import (
+	_ "embed"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/digdon/2025aoc/export"
+	"github.com/digdon/2025aoc/grid"
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/solver"
+	"github.com/digdon/2025aoc/trace"
+)
+
+//go:embed example.txt
+var example string
+
+var tracer = trace.For(2025, 8)
+
+func init() {
+	solver.Register(2025, 8, func() solver.Solver { return &Solver{Connections: 1000} })
+	solver.RegisterExamples(2025, 8, solver.Example{
+		Input: example,
+		Part1: 40,
+		Part2: 25272,
+		// The example only makes 10 connections for part 1
+		New: func() solver.Solver { return &Solver{Connections: 10} },
+	})
+}
+
+type Solver struct {
+	// Number of shortest connections to make for part 1 (1000 for the real input, 10 for the example)
+	Connections int
+
+	points []Point
+	edges  []Edge
+}
+
+func (s *Solver) Parse(lines []string) error {
+	// Parse all of the 3D points from the input
+	var errs input.Errors
+
+	for i, line := range lines {
+		values, err := input.Ints(line)
+		if err != nil {
+			errs.Add(i+1, line, "X,Y,Z", err)
+			continue
+		}
+
+		if len(values) != 3 {
+			errs.Add(i+1, line, "X,Y,Z", fmt.Errorf("found %d coordinates", len(values)))
+			continue
+		}
+
+		s.points = append(s.points, Point{X: values[0], Y: values[1], Z: values[2]})
+	}
+
+	s.edges = sortedEdges(s.points)
+
+	return errs.Err()
+}
+
+func (s *Solver) Part1() (any, error) {
+	circuitList := []map[Point]bool{}
+	edges := s.edges
+
+	for i := range min(s.Connections, len(edges)) {
+		circuitList = connect(circuitList, edges[i])
+	}
+
+	if len(circuitList) < 3 {
+		return nil, fmt.Errorf("only %d circuits after %d connections", len(circuitList), s.Connections)
+	}
+
+	sort.Slice(circuitList, func(i, j int) bool {
+		return len(circuitList[i]) > len(circuitList[j])
+	})
+
+	tracer.Info("circuits formed", "connections", min(s.Connections, len(edges)), "circuits", len(circuitList),
+		"largest", []int{len(circuitList[0]), len(circuitList[1]), len(circuitList[2])})
+
+	total := 1
+	for i := range 3 {
+		total *= len(circuitList[i])
+	}
+
+	return total, nil
+}
+
+func (s *Solver) Part2() (any, error) {
+	circuitList := []map[Point]bool{}
+
+	for i, edge := range s.edges {
+		circuitList = connect(circuitList, edge)
+
+		if len(circuitList) == 1 && len(circuitList[0]) == len(s.points) {
+			// All points are now connected
+			tracer.Info("single circuit formed", "connections", i+1, "last", edge)
+			return edge.A.X * edge.B.X, nil
+		}
+	}
+
+	return nil, fmt.Errorf("points never formed a single circuit")
+}
+
+// Draws the junction boxes and part 1's connections, projected isometrically. The three largest circuits
+// are picked out in red, green and blue, the other circuits in orange, and unconnected boxes in grey.
+func (s *Solver) Snapshot() (image.Image, error) {
+	if len(s.points) == 0 {
+		return nil, fmt.Errorf("no junction boxes to draw")
+	}
+
+	projected := make([]grid.Point, len(s.points))
+	for i, p := range s.points {
+		projected[i] = project(p)
+	}
+
+	plot := export.NewPlot(1000, projected)
+	circuitList := []map[Point]bool{}
+	connections := s.edges[:min(s.Connections, len(s.edges))]
+
+	for _, edge := range connections {
+		circuitList = connect(circuitList, edge)
+		plot.Line(project(edge.A), project(edge.B), export.Grey)
+	}
+
+	sort.Slice(circuitList, func(i, j int) bool {
+		return len(circuitList[i]) > len(circuitList[j])
+	})
+
+	colours := map[Point]color.Color{}
+	for i, circuit := range circuitList {
+		c := export.Orange
+		if i < 3 {
+			c = []color.RGBA{export.Red, export.Green, export.Blue}[i]
+		}
+
+		for p := range circuit {
+			colours[p] = c
+		}
+	}
+
+	for _, p := range s.points {
+		c, found := colours[p]
+		if !found {
+			c = export.White
+		}
+
+		plot.Point(project(p), c, 2)
+	}
+
+	return plot.Image(), nil
+}
+
+// An isometric view: X and Z run off at 30 degrees either side, with Y straight down
+func project(p Point) grid.Point {
+	return grid.Point{
+		X: int(float64(p.X-p.Z) * math.Cos(math.Pi/6)),
+		Y: p.Y + int(float64(p.X+p.Z)*math.Sin(math.Pi/6)),
+	}
+}
+
+// Calculates the distances between every pair of points, sorted by distance (shortest to longest). Both
+// parts need the same list, so it's built once, up front.
+func sortedEdges(points []Point) []Edge {
+	edges := []Edge{}
+
+	for i := 0; i < len(points); i++ {
+		for j := i + 1; j < len(points); j++ {
+			edges = append(edges, Edge{A: points[i], B: points[j], distance: distance(points[i], points[j])})
+		}
+	}
+
+	slices.SortFunc(edges, func(a, b Edge) int {
+		return int(a.distance - b.distance)
+	})
+
+	return edges
+}
+
+// Adds an edge to the circuit groups, returning the updated list
+func connect(circuitList []map[Point]bool, edge Edge) []map[Point]bool {
+	a, b := edge.A, edge.B
+
+	// Check to see if a and/or b are part of any existing circuit
+	existAIdx := findCircuitIndex(circuitList, a)
+	existBIdx := findCircuitIndex(circuitList, b)
+
+	if existAIdx == -1 && existBIdx == -1 {
+		// Neither point is part of an existing circuit, so create a new one
+		newCircuit := map[Point]bool{}
+		newCircuit[a] = true
+		newCircuit[b] = true
+		circuitList = append(circuitList, newCircuit)
+	} else if existAIdx != -1 && existBIdx == -1 {
+		// Only a is part of an existing circuit, so add b to it
+		circuitList[existAIdx][b] = true
+	} else if existAIdx == -1 && existBIdx != -1 {
+		// Only b is part of an existing circuit, so add a to it
+		circuitList[existBIdx][a] = true
+	} else if existAIdx != existBIdx {
+		// Both points are part of different circuits, so merge them
+		tracer.Debug("circuits merged", "sizes", []int{len(circuitList[existAIdx]), len(circuitList[existBIdx])}, "circuits", len(circuitList)-1)
+		for p := range circuitList[existBIdx] {
+			circuitList[existAIdx][p] = true
+		}
+
+		// Remove B circuit from the active list
+		circuitList = append(circuitList[:existBIdx], circuitList[existBIdx+1:]...)
+	}
+
+	return circuitList
+}
+
+func findCircuitIndex(circuitList []map[Point]bool, p Point) int {
+	for i, circuit := range circuitList {
+		if circuit[p] {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func distance(a, b Point) float64 {
+	dx := float64(b.X - a.X)
+	dy := float64(b.Y - a.Y)
+	dz := float64(b.Z - a.Z)
+
+	return math.Sqrt(dx*dx + dy*dy + dz*dz)
+}
+
+type Edge struct {
+	A        Point
+	B        Point
+	distance float64
+}
+
+type Point struct {
+	X int
+	Y int
+	Z int
+}
@@ -0,0 +1,12 @@
+package day08
+
+import (
+	"testing"
+
+	"github.com/digdon/2025aoc/solver"
+	"github.com/digdon/2025aoc/solver/solvertest"
+)
+
+func FuzzParse(f *testing.F) {
+	solvertest.FuzzParse(f, 2025, 8, func() solver.Solver { return &Solver{Connections: 1000} })
+}
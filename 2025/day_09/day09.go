@@ -0,0 +1,269 @@
+package day09
+
+import (
+	_ "embed"
+	"fmt"
+	"image"
+
+	"github.com/digdon/2025aoc/export"
+	"github.com/digdon/2025aoc/grid"
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/solver"
+)
+
+//go:embed example.txt
+var example string
+
+func init() {
+	solver.Register(2025, 9, func() solver.Solver { return &Solver{} })
+	solver.RegisterExamples(2025, 9, solver.Example{Input: example, Part1: 50, Part2: 24})
+}
+
+type Solver struct {
+	points []grid.Point
+}
+
+func (s *Solver) Parse(lines []string) error {
+	var errs input.Errors
+
+	for i, line := range lines {
+		values, err := input.Ints(line)
+		if err != nil {
+			errs.Add(i+1, line, "X,Y", err)
+			continue
+		}
+
+		if len(values) != 2 {
+			errs.Add(i+1, line, "X,Y", fmt.Errorf("found %d coordinates", len(values)))
+			continue
+		}
+
+		s.points = append(s.points, grid.Point{X: values[0], Y: values[1]})
+	}
+
+	return errs.Err()
+}
+
+func (s *Solver) Part1() (any, error) {
+	return part1(s.points).Area, nil
+}
+
+func (s *Solver) Part2() (any, error) {
+	return part2(s.points, containedSimplified).Area, nil
+}
+
+// The general containment check, kept for comparison with the simplified one
+func (s *Solver) Variants() []solver.Variant {
+	return []solver.Variant{
+		{Name: "general", Part: 2, Solve: func() (any, error) { return part2(s.points, contained).Area, nil }},
+	}
+}
+
+// Draws the red tiles and the outline joining them, with part 2's best rectangle shaded in blue. This uses
+// the general containment check: the simplified one can pick an equally big rectangle sitting outside the
+// shape (it does on the example), which gets the right area but the wrong picture.
+func (s *Solver) Snapshot() (image.Image, error) {
+	if len(s.points) == 0 {
+		return nil, fmt.Errorf("no red tiles to draw")
+	}
+
+	plot := export.NewPlot(1000, s.points)
+	best := part2(s.points, contained)
+
+	plot.FillRect(best.A, best.B, export.Blue)
+	plot.Polygon(s.points, export.Green)
+
+	for _, p := range s.points {
+		plot.Point(p, export.Red, 1)
+	}
+
+	return plot.Image(), nil
+}
+
+func part1(points []grid.Point) Rectangle {
+	var maxA, maxB grid.Point
+	var maxArea int
+
+	for i := 0; i < len(points)-1; i++ {
+		a := points[i]
+
+		for j := i + 1; j < len(points); j++ {
+			b := points[j]
+
+			aDiff := a.X - b.X
+			if aDiff < 0 {
+				aDiff = -aDiff
+			}
+
+			bDiff := a.Y - b.Y
+			if bDiff < 0 {
+				bDiff = -bDiff
+			}
+
+			area := (aDiff + 1) * (bDiff + 1)
+
+			if area > maxArea {
+				maxArea = area
+				maxA = a
+				maxB = b
+			}
+		}
+	}
+
+	return Rectangle{A: maxA, B: maxB, Area: maxArea}
+}
+
+type containedFunc func(topLeft, bottomRight grid.Point, horizontalEdges, verticalEdges []Edge) bool
+
+func part2(points []grid.Point, isContained containedFunc) Rectangle {
+	// Find all of the edges, categorized into horizontal and vertical
+	horizontalEdges, verticalEdges := calculateEdges(points)
+
+	// Build a rectangle for each pair of points, then check to see if it falls fully within the shape
+	var maxA, maxB grid.Point
+	var maxArea int
+
+	for i := 0; i < len(points)-1; i++ {
+		a := points[i]
+
+		for j := i + 1; j < len(points); j++ {
+			b := points[j]
+
+			// The rectangle
+			topLeft := grid.Point{X: min(a.X, b.X), Y: min(a.Y, b.Y)}
+			bottomRight := grid.Point{X: max(a.X, b.X), Y: max(a.Y, b.Y)}
+
+			// Check to see if the rectangle is fully contained within the shape
+			if isContained(topLeft, bottomRight, horizontalEdges, verticalEdges) {
+				// Rectangle is fully contained
+				area := (bottomRight.X - topLeft.X + 1) * (bottomRight.Y - topLeft.Y + 1)
+
+				if area > maxArea {
+					maxArea = area
+					maxA = a
+					maxB = b
+				}
+			}
+		}
+	}
+
+	return Rectangle{A: maxA, B: maxB, Area: maxArea}
+}
+
+func calculateEdges(points []grid.Point) ([]Edge, []Edge) {
+	// Work out each edge. Organize them based on horizontal vs vertical. For each edge, record if the left side is "inside"
+	horizontalEdges, verticalEdges := []Edge{}, []Edge{}
+
+	for i := range points {
+		var a, b grid.Point
+		a = points[i]
+
+		if i == len(points)-1 {
+			b = points[0]
+		} else {
+			b = points[i+1]
+		}
+
+		if a.X == b.X {
+			// Vertical edge
+			if b.Y > a.Y {
+				verticalEdges = append(verticalEdges, Edge{A: a, B: b})
+			} else {
+				verticalEdges = append(verticalEdges, Edge{A: b, B: a})
+			}
+		}
+
+		if a.Y == b.Y {
+			// Horizontal edge
+			if b.X > a.X {
+				horizontalEdges = append(horizontalEdges, Edge{A: a, B: b})
+			} else {
+				horizontalEdges = append(horizontalEdges, Edge{A: b, B: a})
+			}
+		}
+	}
+
+	return horizontalEdges, verticalEdges
+}
+
+// This is a simplified version of contained() that only checks if edges fall fully within the rectangle. This probably
+// only works for the specific input data, but is not a general solution.
+func containedSimplified(topLeft, bottomRight grid.Point, horizontalEdges, verticalEdges []Edge) bool {
+	// Check horizontal edges
+	for _, edge := range horizontalEdges {
+		// Does the edge horizontally line up with the rectangle?
+		if edge.A.X >= bottomRight.X || edge.B.X <= topLeft.X {
+			// Falls completely outside horizontally, so ignore it
+			continue
+		}
+
+		if edge.A.Y > topLeft.Y && edge.A.Y < bottomRight.Y {
+			// Edge falls within the rectangle, so it's not contained
+			return false
+		}
+	}
+
+	// Check vertical edges
+	for _, edge := range verticalEdges {
+		// Does the edge vertically line up with the rectangle?
+		if edge.A.Y >= bottomRight.Y || edge.B.Y <= topLeft.Y {
+			// Falls completely outside vertically, so ignore it
+			continue
+		}
+
+		if edge.A.X > topLeft.X && edge.A.X < bottomRight.X {
+			// Edge falls within the rectangle, so it's not contained
+			return false
+		}
+	}
+
+	return true
+}
+
+// The general version of the check. Along with making sure that no edges cut through the rectangle, it also
+// makes sure the rectangle is actually inside the shape, rather than sitting in a notch outside of it.
+func contained(topLeft, bottomRight grid.Point, horizontalEdges, verticalEdges []Edge) bool {
+	if !containedSimplified(topLeft, bottomRight, horizontalEdges, verticalEdges) {
+		return false
+	}
+
+	// Nothing crosses the rectangle, so it's either all inside or all outside. Check the centre point to
+	// find out which. Coordinates are doubled so the centre stays on the integer grid.
+	cx, cy := topLeft.X+bottomRight.X, topLeft.Y+bottomRight.Y
+
+	// Points on the boundary count as inside
+	for _, edge := range horizontalEdges {
+		if cy == edge.A.Y*2 && cx >= edge.A.X*2 && cx <= edge.B.X*2 {
+			return true
+		}
+	}
+
+	for _, edge := range verticalEdges {
+		if cx == edge.A.X*2 && cy >= edge.A.Y*2 && cy <= edge.B.Y*2 {
+			return true
+		}
+	}
+
+	// Cast a ray to the right, counting the vertical edges it crosses
+	crossings := 0
+
+	for _, edge := range verticalEdges {
+		if edge.A.X*2 > cx && cy >= edge.A.Y*2 && cy < edge.B.Y*2 {
+			crossings++
+		}
+	}
+
+	return crossings%2 == 1
+}
+
+// The biggest rectangle found, along with the red tiles at its opposite corners
+type Rectangle struct {
+	A    grid.Point
+	B    grid.Point
+	Area int
+}
+
+type Edge struct {
+	A grid.Point
+	B grid.Point
+}
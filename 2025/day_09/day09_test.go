@@ -0,0 +1,16 @@
+package day09
+
+import (
+	"testing"
+
+	"github.com/digdon/2025aoc/solver"
+	"github.com/digdon/2025aoc/solver/solvertest"
+)
+
+func BenchmarkVariants(b *testing.B) {
+	solvertest.BenchmarkVariants(b, 2025, 9, func() solver.Solver { return &Solver{} })
+}
+
+func FuzzParse(f *testing.F) {
+	solvertest.FuzzParse(f, 2025, 9, func() solver.Solver { return &Solver{} })
+}
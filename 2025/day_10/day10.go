@@ -1,59 +1,100 @@
-package main
+package day10
 
 import (
-	"bufio"
+	"context"
+	_ "embed"
+	"errors"
 	"fmt"
-	"log"
 	"math"
-	"os"
 	"strconv"
 	"strings"
-	"time"
+
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/parallel"
+	"github.com/digdon/2025aoc/solver"
 )
 
-func main() {
-	var inputLines []string
-	scanner := bufio.NewScanner(os.Stdin)
+//go:embed example.txt
+var example string
 
-	for scanner.Scan() {
-		inputLines = append(inputLines, scanner.Text())
-	}
+func init() {
+	solver.Register(2025, 10, func() solver.Solver { return &Solver{} })
+	solver.RegisterExamples(2025, 10, solver.Example{Input: example, Part1: 7, Part2: 33})
+}
 
-	if err := scanner.Err(); err != nil {
-		log.Println(err)
-		os.Exit(1)
-	}
+type Solver struct {
+	machines []Machine
+}
+
+func (s *Solver) Parse(lines []string) error {
+	var errs input.Errors
 
 	// Parse out all of the machine data
-	machines := []Machine{}
+	for i, line := range lines {
+		machine, err := parseMachine(line)
+		if err != nil {
+			errs.Add(i+1, line, machineFormat, err)
+			continue
+		}
 
-	for _, line := range inputLines {
-		machines = append(machines, parseMachine(line))
+		s.machines = append(s.machines, machine)
 	}
 
-	// Part 1 stuff
-	begin := time.Now()
+	return errs.Err()
+}
 
-	totalPresses := 0
+func (s *Solver) Part1() (any, error) {
+	return s.part1(context.Background())
+}
 
-	for _, machine := range machines {
-		totalPresses += part1MinPresses(machine)
+func (s *Solver) Part2() (any, error) {
+	return s.part2(context.Background())
+}
+
+// PartContext gives up once ctx is cancelled. Both parts try every combination of a machine's buttons,
+// which doubles with each button, so a machine with a few dozen of them would otherwise run for years.
+func (s *Solver) PartContext(ctx context.Context, part int) (any, error) {
+	switch part {
+	case 1:
+		return s.part1(ctx)
+	case 2:
+		return s.part2(ctx)
 	}
 
-	fmt.Printf("Part 1: %d (%v)\n", totalPresses, time.Since(begin))
+	return nil, fmt.Errorf("invalid part %d", part)
+}
+
+// Every machine is independent, so they're spread across the worker pool
+func (s *Solver) part1(ctx context.Context) (any, error) {
+	totalPresses := parallel.Sum(len(s.machines), func(i int) int {
+		return part1MinPresses(ctx, s.machines[i])
+	})
 
-	// Part 2 stuff
-	begin = time.Now()
+	// Machines cut short give nonsense, so the total is no good
+	if ctx.Err() != nil {
+		return nil, context.Cause(ctx)
+	}
 
-	totalPresses = 0
+	return totalPresses, nil
+}
+
+func (s *Solver) part2(ctx context.Context) (any, error) {
+	totalPresses := parallel.Sum(len(s.machines), func(i int) int {
+		machine := s.machines[i]
+		patterns := generatePatterns(ctx, len(machine.joltages), machine.buttons)
+		if ctx.Err() != nil {
+			return 0
+		}
 
-	for _, machine := range machines {
-		patterns := generatePatterns(len(machine.joltages), machine.buttons)
 		cache := map[string]int{}
-		totalPresses += part2MinPresses(machine.joltages, patterns, cache)
+		return part2MinPresses(machine.joltages, patterns, cache)
+	})
+
+	if ctx.Err() != nil {
+		return nil, context.Cause(ctx)
 	}
 
-	fmt.Printf("Part 2: %d (%v)\n", totalPresses, time.Since(begin))
+	return totalPresses, nil
 }
 
 type Machine struct {
@@ -62,60 +103,110 @@ type Machine struct {
 	joltages []int
 }
 
-func parseMachine(line string) Machine {
-	parts := strings.Split(line, " ")
+const machineFormat = "[lights] (button) ... {joltages}"
+
+func parseMachine(line string) (Machine, error) {
+	parts := strings.Fields(line)
+
+	if len(parts) < 2 {
+		return Machine{}, errors.New("missing lights or joltages")
+	}
 
 	// Parse out the target light config
 	lights := rune(0)
-	lightsString := parts[0][1 : len(parts[0])-1]
+	lightsString, err := unwrap(parts[0], '[', ']')
+	if err != nil {
+		return Machine{}, err
+	}
+
+	if len(lightsString) > 31 {
+		return Machine{}, fmt.Errorf("too many lights (%d)", len(lightsString))
+	}
 
 	for i := range lightsString {
-		if lightsString[i] == '#' {
+		switch lightsString[i] {
+		case '#':
 			lights |= 1 << i
+		case '.':
+		default:
+			return Machine{}, fmt.Errorf("invalid light %q", lightsString[i])
 		}
 	}
 
 	// Parse out the joltages
-	joltages := []int{}
-	joltageStrings := strings.Split(parts[len(parts)-1][1:len(parts[len(parts)-1])-1], ",")
-
-	for i := range joltageStrings {
-		val, err := strconv.Atoi(joltageStrings[i])
+	joltageString, err := unwrap(parts[len(parts)-1], '{', '}')
+	if err != nil {
+		return Machine{}, err
+	}
 
-		if err != nil {
-			log.Fatalf("Error parsing joltage value: %v", err)
-		}
+	joltages, err := parseValues(joltageString)
+	if err != nil {
+		return Machine{}, fmt.Errorf("error parsing joltage value: %w", err)
+	}
 
-		joltages = append(joltages, val)
+	if len(joltages) != len(lightsString) {
+		return Machine{}, fmt.Errorf("%d joltages for %d lights", len(joltages), len(lightsString))
 	}
 
 	// Parse out the buttons. A button is represented as a list of light indices it toggles.
 	buttons := [][]int{}
 
 	for i := 1; i < len(parts)-1; i++ {
-		buttonValues := strings.Split(parts[i][1:len(parts[i])-1], ",")
-		button := []int{}
+		buttonString, err := unwrap(parts[i], '(', ')')
+		if err != nil {
+			return Machine{}, err
+		}
 
-		for j := range buttonValues {
-			val, err := strconv.Atoi(buttonValues[j])
+		button, err := parseValues(buttonString)
+		if err != nil {
+			return Machine{}, fmt.Errorf("error parsing button value: %w", err)
+		}
 
-			if err != nil {
-				log.Fatalf("Error parsing button value: %v", err)
+		for _, lightIndex := range button {
+			if lightIndex < 0 || lightIndex >= len(lightsString) {
+				return Machine{}, fmt.Errorf("button %s refers to light %d, but there are only %d lights", parts[i], lightIndex, len(lightsString))
 			}
-
-			button = append(button, val)
 		}
 
 		buttons = append(buttons, button)
 	}
 
-	return Machine{lights: lights, buttons: buttons, joltages: joltages}
+	return Machine{lights: lights, buttons: buttons, joltages: joltages}, nil
 }
 
-func part1MinPresses(machine Machine) int {
+// Strips the surrounding brackets from a part of the machine description
+func unwrap(part string, open, close byte) (string, error) {
+	if len(part) < 2 || part[0] != open || part[len(part)-1] != close {
+		return "", fmt.Errorf("expected %c...%c, got %q", open, close, part)
+	}
+
+	return part[1 : len(part)-1], nil
+}
+
+func parseValues(str string) ([]int, error) {
+	values := []int{}
+
+	for _, valueString := range strings.Split(str, ",") {
+		val, err := strconv.Atoi(valueString)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, val)
+	}
+
+	return values, nil
+}
+
+// Stops early if ctx is cancelled, in which case the answer is meaningless
+func part1MinPresses(ctx context.Context, machine Machine) int {
 	minPresses := math.MaxInt
 
 	for i := range 1 << len(machine.buttons) {
+		if i%cancelCheck == 0 && ctx.Err() != nil {
+			break
+		}
+
 		// Iterate through all possible button press combinations (button numbers represented by bits in i)
 		lights := rune(0)
 		presses := 0
@@ -141,6 +232,10 @@ func part1MinPresses(machine Machine) int {
 	return minPresses
 }
 
+// How many button combinations to try between checks for cancellation. Checking every time would cost
+// more than most combinations take.
+const cancelCheck = 1 << 16
+
 type PatternInfo struct {
 	pattern []int
 	cost    int
@@ -150,10 +245,15 @@ type PatternInfo struct {
 // and groups them by their resulting parity pattern (i.e., which lights are on/off after applying the pattern).
 // For each parity pattern, it keeps track of the various light hit counts that can produce that parity,
 // along with the minimum cost (number of button presses) to achieve that particular light hit count.
-func generatePatterns(numLights int, buttons [][]int) map[rune]map[string]PatternInfo {
+// The patterns are incomplete if ctx is cancelled part way through.
+func generatePatterns(ctx context.Context, numLights int, buttons [][]int) map[rune]map[string]PatternInfo {
 	patterns := map[rune]map[string]PatternInfo{}
 
 	for i := range 1 << len(buttons) {
+		if i%cancelCheck == 0 && ctx.Err() != nil {
+			break
+		}
+
 		lightCounts := make([]int, numLights)
 		presses := 0
 
@@ -0,0 +1,135 @@
+package day11
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/solver"
+)
+
+//go:embed example.txt
+var example string
+
+//go:embed example2.txt
+var example2 string
+
+func init() {
+	solver.Register(2025, 11, func() solver.Solver { return &Solver{} })
+	solver.RegisterExamples(2025, 11,
+		solver.Example{Name: "part 1", Input: example, Part1: 5},
+		solver.Example{Name: "part 2", Input: example2, Part2: 2},
+	)
+}
+
+type Solver struct {
+	connections map[string][]string
+}
+
+func (s *Solver) Parse(lines []string) error {
+	var errs input.Errors
+	s.connections = map[string][]string{}
+
+	for i, line := range lines {
+		idx := strings.Index(line, ":")
+		if idx < 1 {
+			errs.Add(i+1, line, "device: output ...", errors.New("missing device name"))
+			continue
+		}
+
+		device := line[:idx]
+		connectedDevices := strings.Fields(line[idx+1:])
+		s.connections[device] = connectedDevices
+	}
+
+	return errs.Err()
+}
+
+func (s *Solver) Part1() (any, error) {
+	return findPart1Paths(s.connections, "you", map[string]int{}, map[string]bool{})
+}
+
+func (s *Solver) Part2() (any, error) {
+	return findPart2Paths(s.connections, "svr", &map[string]bool{}, map[string]int{}, map[string]bool{})
+}
+
+// Counting paths only works if they can't go round in circles, so devices that are still being explored are
+// marked in progress. Reaching one of those again means the connections loop.
+func loopError(device string) error {
+	return fmt.Errorf("the connections loop back to %s", device)
+}
+
+func findPart1Paths(connections map[string][]string, device string, cache map[string]int, inProgress map[string]bool) (int, error) {
+	if device == "out" {
+		return 1, nil
+	}
+
+	if cachedVal, found := cache[device]; found {
+		return cachedVal, nil
+	}
+
+	if inProgress[device] {
+		return 0, loopError(device)
+	}
+
+	inProgress[device] = true
+	totalPaths := 0
+
+	for _, connectedDevice := range connections[device] {
+		paths, err := findPart1Paths(connections, connectedDevice, cache, inProgress)
+		if err != nil {
+			return 0, err
+		}
+
+		totalPaths += paths
+	}
+
+	inProgress[device] = false
+	cache[device] = totalPaths
+
+	return totalPaths, nil
+}
+
+func findPart2Paths(connections map[string][]string, device string, visited *map[string]bool, cache map[string]int, inProgress map[string]bool) (int, error) {
+	// Check the cache to see if we've already computed this
+	cachekey := fmt.Sprintf("%s-%t-%t", device, (*visited)["dac"], (*visited)["fft"])
+	cachedVal, found := cache[cachekey]
+
+	if found {
+		return cachedVal, nil
+	}
+
+	if device == "out" {
+		if (*visited)["dac"] && (*visited)["fft"] {
+			return 1, nil
+		} else {
+			return 0, nil
+		}
+	}
+
+	if inProgress[device] {
+		return 0, loopError(device)
+	}
+
+	inProgress[device] = true
+	totalPaths := 0
+
+	for _, connectedDevice := range connections[device] {
+		(*visited)[connectedDevice] = true
+		paths, err := findPart2Paths(connections, connectedDevice, visited, cache, inProgress)
+		(*visited)[connectedDevice] = false
+
+		if err != nil {
+			return 0, err
+		}
+
+		totalPaths += paths
+	}
+
+	inProgress[device] = false
+	cache[cachekey] = totalPaths
+
+	return totalPaths, nil
+}
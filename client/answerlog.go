@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Submission is a single answer sent to the site, and what came back
+type Submission struct {
+	Day     int       `json:"day"`
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Time    time.Time `json:"time"`
+	Outcome Outcome   `json:"outcome"`
+	Hint    string    `json:"hint,omitempty"`
+}
+
+// AnswerLog records every submission, along with when the site will next accept an answer
+type AnswerLog struct {
+	Submissions []Submission `json:"submissions"`
+	NextAllowed time.Time    `json:"next_allowed"`
+}
+
+func LoadAnswerLog(path string) (*AnswerLog, error) {
+	log := &AnswerLog{}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return log, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, log); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return log, nil
+}
+
+func (l *AnswerLog) Save(path string) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Solved returns the accepted answer for a part, if there is one
+func (l *AnswerLog) Solved(day, part int) (string, bool) {
+	for _, s := range l.Submissions {
+		if s.Day == day && s.Part == part && s.Outcome == Correct {
+			return s.Answer, true
+		}
+	}
+
+	return "", false
+}
+
+// Check looks for reasons not to bother submitting an answer: the part is already solved, the answer has
+// already been rejected, an earlier "too high"/"too low" hint rules it out, or the site's cooldown hasn't
+// passed yet.
+func (l *AnswerLog) Check(day, part int, answer string, now time.Time) error {
+	if solved, found := l.Solved(day, part); found {
+		if solved == answer {
+			return fmt.Errorf("day %d part %d is already solved with %s", day, part, solved)
+		}
+
+		return fmt.Errorf("day %d part %d is already solved with %s, not %s", day, part, solved, answer)
+	}
+
+	value, numErr := strconv.Atoi(answer)
+
+	for _, s := range l.Submissions {
+		if s.Day != day || s.Part != part || s.Outcome != Incorrect {
+			continue
+		}
+
+		if s.Answer == answer {
+			return fmt.Errorf("%s was already rejected for day %d part %d (%s)", answer, day, part, s.Time.Format(time.DateTime))
+		}
+
+		previous, err := strconv.Atoi(s.Answer)
+		if numErr != nil || err != nil {
+			continue
+		}
+
+		if s.Hint == "too high" && value >= previous {
+			return fmt.Errorf("%s can't be right: %s was already too high", answer, s.Answer)
+		}
+
+		if s.Hint == "too low" && value <= previous {
+			return fmt.Errorf("%s can't be right: %s was already too low", answer, s.Answer)
+		}
+	}
+
+	if wait := l.NextAllowed.Sub(now); wait > 0 {
+		return fmt.Errorf("the site won't accept another answer for %v", wait.Round(time.Second))
+	}
+
+	return nil
+}
+
+// Record adds a submission and its response to the log, noting any cooldown the site asked for
+func (l *AnswerLog) Record(day, part int, answer string, resp Response, now time.Time) {
+	if resp.Outcome != TooRecent {
+		l.Submissions = append(l.Submissions, Submission{
+			Day:     day,
+			Part:    part,
+			Answer:  answer,
+			Time:    now,
+			Outcome: resp.Outcome,
+			Hint:    resp.Hint,
+		})
+	}
+
+	if resp.Wait > 0 {
+		l.NextAllowed = now.Add(resp.Wait)
+	}
+}
+
+// SubmitChecked runs an answer past the log's guardrails, submits it, and records the result. The log
+// isn't saved; that's up to the caller.
+func SubmitChecked(ctx context.Context, c *Client, log *AnswerLog, day, part int, answer string) (Response, error) {
+	if err := log.Check(day, part, answer, time.Now()); err != nil {
+		return Response{}, err
+	}
+
+	resp, err := c.Submit(ctx, day, part, answer)
+	if err != nil {
+		return Response{}, err
+	}
+
+	log.Record(day, part, answer, resp, time.Now())
+
+	return resp, nil
+}
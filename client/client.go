@@ -0,0 +1,164 @@
+// Package client talks to the Advent of Code site. Everything goes through a configurable base URL, so a
+// local stub server (ie, httptest) can stand in for the real site.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoSession is returned when a request needs the session cookie but none is configured
+var ErrNoSession = errors.New("no session cookie configured (set it in the config file or AOC_SESSION)")
+
+type Client struct {
+	cfg  Config
+	http *http.Client
+
+	mu          sync.Mutex
+	lastRequest time.Time
+}
+
+func New(cfg Config) *Client {
+	return &Client{cfg: cfg, http: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// UserAgent identifies the tool (and whoever is running it) to the site
+func (c *Client) UserAgent() string {
+	ua := "github.com/digdon/2025aoc"
+
+	if c.cfg.Contact != "" {
+		ua += " by " + c.cfg.Contact
+	}
+
+	return ua
+}
+
+// Input downloads the puzzle input for a day. Use FetchInput to go through the on-disk cache instead.
+func (c *Client) Input(ctx context.Context, day int) ([]byte, error) {
+	resp, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%d/day/%d/input", c.cfg.Year, day), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return io.ReadAll(resp.Body)
+}
+
+// Sends a request, waiting first if the previous one was too recent. Anything other than a 200 is an error.
+func (c *Client) do(ctx context.Context, method, path string, form url.Values) (*http.Response, error) {
+	if c.cfg.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	if err := c.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.cfg.BaseURL, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", c.UserAgent())
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.cfg.Session})
+
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		resp.Body.Close()
+
+		return nil, fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	return resp, nil
+}
+
+// Rate limiting: holds off until MinInterval has passed since the last request
+func (c *Client) wait(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if delay := time.Until(c.lastRequest.Add(time.Duration(c.cfg.MinInterval))); delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	c.lastRequest = time.Now()
+
+	return nil
+}
+
+// InputPath returns where a day's input is cached
+func InputPath(dir string, day int) string {
+	return filepath.Join(dir, fmt.Sprintf("day%02d.txt", day))
+}
+
+// FetchInput makes sure a day's input is in the cache directory, downloading it only if it isn't there
+// already. It returns the cached file's path and whether a download happened.
+func FetchInput(ctx context.Context, c *Client, dir string, day int) (string, bool, error) {
+	path := InputPath(dir, day)
+
+	if _, err := os.Stat(path); err == nil {
+		return path, false, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", false, err
+	}
+
+	data, err := c.Input(ctx, day)
+	if err != nil {
+		return "", false, err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", false, err
+	}
+
+	// Write to a temporary file first, so an interrupted download never looks like a cached input
+	tmp, err := os.CreateTemp(dir, ".fetch-*")
+	if err != nil {
+		return "", false, err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", false, err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", false, err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return "", false, err
+	}
+
+	return path, true, nil
+}
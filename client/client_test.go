@@ -0,0 +1,221 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A request as the fake site saw it
+type seenRequest struct {
+	at        time.Time
+	method    string
+	path      string
+	session   string
+	userAgent string
+	form      map[string]string
+}
+
+// fakeSite stands in for adventofcode.com, recording every request and answering with handler
+type fakeSite struct {
+	*httptest.Server
+
+	mu   sync.Mutex
+	seen []seenRequest
+}
+
+func newFakeSite(t *testing.T, handler http.HandlerFunc) *fakeSite {
+	t.Helper()
+
+	site := &fakeSite{}
+	site.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := seenRequest{at: time.Now(), method: r.Method, path: r.URL.Path, userAgent: r.UserAgent(), form: map[string]string{}}
+
+		if cookie, err := r.Cookie("session"); err == nil {
+			req.session = cookie.Value
+		}
+
+		if err := r.ParseForm(); err == nil {
+			for key := range r.PostForm {
+				req.form[key] = r.PostForm.Get(key)
+			}
+		}
+
+		site.mu.Lock()
+		site.seen = append(site.seen, req)
+		site.mu.Unlock()
+
+		handler(w, r)
+	}))
+	t.Cleanup(site.Close)
+
+	return site
+}
+
+func (s *fakeSite) requests() []seenRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]seenRequest(nil), s.seen...)
+}
+
+func (s *fakeSite) client() *Client {
+	return New(Config{BaseURL: s.URL, Year: 2025, Session: "secret", Contact: "me@example.com"})
+}
+
+func serveInput(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte("L68\nR5\n"))
+}
+
+func TestFetchInputDownloads(t *testing.T) {
+	site := newFakeSite(t, serveInput)
+	dir := filepath.Join(t.TempDir(), "2025")
+
+	path, fetched, err := FetchInput(context.Background(), site.client(), dir, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !fetched || path != filepath.Join(dir, "day07.txt") {
+		t.Errorf("got %s (fetched %v), want a fresh download to %s", path, fetched, filepath.Join(dir, "day07.txt"))
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "L68\nR5\n" {
+		t.Errorf("cached %q, want the downloaded input", data)
+	}
+
+	seen := site.requests()
+	if len(seen) != 1 {
+		t.Fatalf("got %d requests, want 1", len(seen))
+	}
+
+	req := seen[0]
+	if req.method != http.MethodGet || req.path != "/2025/day/7/input" {
+		t.Errorf("requested %s %s, want GET /2025/day/7/input", req.method, req.path)
+	}
+
+	if req.session != "secret" {
+		t.Errorf("session cookie %q, want %q", req.session, "secret")
+	}
+
+	if want := "github.com/digdon/2025aoc by me@example.com"; req.userAgent != want {
+		t.Errorf("User-Agent %q, want %q", req.userAgent, want)
+	}
+}
+
+func TestFetchInputCacheHit(t *testing.T) {
+	site := newFakeSite(t, serveInput)
+	dir := t.TempDir()
+
+	if err := os.WriteFile(InputPath(dir, 3), []byte("cached\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	path, fetched, err := FetchInput(context.Background(), site.client(), dir, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fetched || path != InputPath(dir, 3) {
+		t.Errorf("got %s (fetched %v), want the cached %s", path, fetched, InputPath(dir, 3))
+	}
+
+	if n := len(site.requests()); n != 0 {
+		t.Errorf("a cached input made %d requests", n)
+	}
+}
+
+func TestFetchInputError(t *testing.T) {
+	site := newFakeSite(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+	})
+	dir := t.TempDir()
+
+	_, _, err := FetchInput(context.Background(), site.client(), dir, 1)
+	if err == nil || !strings.Contains(err.Error(), "400") || !strings.Contains(err.Error(), "Puzzle inputs differ") {
+		t.Errorf("got error %v, want the status and the site's message", err)
+	}
+
+	// A failed download mustn't leave anything that looks like a cached input
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 0 {
+		t.Errorf("a failed download left %d files behind", len(entries))
+	}
+}
+
+func TestNoSession(t *testing.T) {
+	site := newFakeSite(t, serveInput)
+	c := New(Config{BaseURL: site.URL, Year: 2025})
+
+	if _, err := c.Input(context.Background(), 1); !errors.Is(err, ErrNoSession) {
+		t.Errorf("got error %v, want ErrNoSession", err)
+	}
+
+	if n := len(site.requests()); n != 0 {
+		t.Errorf("made %d requests without a session", n)
+	}
+}
+
+func TestMinInterval(t *testing.T) {
+	site := newFakeSite(t, serveInput)
+	interval := 50 * time.Millisecond
+
+	c := site.client()
+	c.cfg.MinInterval = Duration(interval)
+
+	for day := 1; day <= 3; day++ {
+		if _, err := c.Input(context.Background(), day); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	seen := site.requests()
+	if len(seen) != 3 {
+		t.Fatalf("got %d requests, want 3", len(seen))
+	}
+
+	for n := 1; n < len(seen); n++ {
+		// The gap is measured at the client, so the server can see slightly less of it
+		if gap := seen[n].at.Sub(seen[n-1].at); gap < interval-5*time.Millisecond {
+			t.Errorf("requests %d and %d were only %v apart, want at least %v", n, n+1, gap, interval)
+		}
+	}
+}
+
+func TestMinIntervalCancelled(t *testing.T) {
+	site := newFakeSite(t, serveInput)
+
+	c := site.client()
+	c.cfg.MinInterval = Duration(time.Hour)
+
+	if _, err := c.Input(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := c.Input(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want the wait for the next slot to be cancelled", err)
+	}
+
+	if n := len(site.requests()); n != 1 {
+		t.Errorf("got %d requests, want only the first", n)
+	}
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	DefaultBaseURL     = "https://adventofcode.com"
+	DefaultYear        = 2025
+	DefaultMinInterval = 5 * time.Second
+)
+
+// Config holds the settings for talking to the Advent of Code site. It's read from a JSON file, with the
+// session cookie optionally coming from the AOC_SESSION environment variable instead.
+type Config struct {
+	BaseURL string `json:"base_url"`
+	Year    int    `json:"year"`
+	Session string `json:"session"`
+
+	// Contact details (ie, an email address) for the User-Agent, as the site asks for
+	Contact string `json:"contact"`
+
+	// Minimum time between requests
+	MinInterval Duration `json:"min_interval"`
+}
+
+// Duration is a time.Duration that reads and writes as a string (ie, "5s") in JSON
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	parsed, err := time.ParseDuration(str)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(parsed)
+
+	return nil
+}
+
+// DefaultConfigPath returns where the config lives if no path is given: aoc/config.json under the
+// user's config directory
+func DefaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "aoc-config.json"
+	}
+
+	return filepath.Join(dir, "aoc", "config.json")
+}
+
+// LoadConfig reads the config file, filling in defaults for anything that's missing. A missing file
+// isn't an error, since everything can come from defaults and the environment.
+func LoadConfig(path string) (Config, error) {
+	cfg := Config{}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return cfg, err
+	}
+
+	if err == nil {
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return cfg, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	if session := os.Getenv("AOC_SESSION"); session != "" {
+		cfg.Session = session
+	}
+
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+
+	if cfg.Year == 0 {
+		cfg.Year = DefaultYear
+	}
+
+	if cfg.MinInterval == 0 {
+		cfg.MinInterval = Duration(DefaultMinInterval)
+	}
+
+	return cfg, nil
+}
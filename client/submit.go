@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Outcome string
+
+const (
+	Correct       Outcome = "correct"
+	Incorrect     Outcome = "incorrect"
+	TooRecent     Outcome = "too recent"
+	AlreadySolved Outcome = "already solved"
+	Unknown       Outcome = "unknown"
+)
+
+// Response is what the site said about a submitted answer
+type Response struct {
+	Outcome Outcome
+
+	// "too high" or "too low", when the site gives a hint for a wrong answer
+	Hint string
+
+	// How long until another answer can be submitted, if the site said
+	Wait time.Duration
+
+	// The text of the response, with the HTML stripped out
+	Message string
+}
+
+// Submit sends an answer for a day and part. It does no checking of its own; see SubmitChecked for the
+// guardrails around it.
+func (c *Client) Submit(ctx context.Context, day, part int, answer string) (Response, error) {
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+
+	resp, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", c.cfg.Year, day), form)
+	if err != nil {
+		return Response{}, err
+	}
+	defer resp.Body.Close()
+
+	page, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Response{}, err
+	}
+
+	return ParseResponse(string(page)), nil
+}
+
+var (
+	articleRE = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRE     = regexp.MustCompile(`<[^>]*>`)
+	spaceRE   = regexp.MustCompile(`\s+`)
+	leftRE    = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	waitRE    = regexp.MustCompile(`[Pp]lease wait (one|\d+) minutes?`)
+)
+
+// ParseResponse works out the outcome of a submission from the page the site sends back
+func ParseResponse(page string) Response {
+	message := page
+	if match := articleRE.FindStringSubmatch(page); match != nil {
+		message = match[1]
+	}
+
+	message = html.UnescapeString(tagRE.ReplaceAllString(message, " "))
+	message = strings.TrimSpace(spaceRE.ReplaceAllString(message, " "))
+
+	r := Response{Outcome: Unknown, Message: message}
+
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		r.Outcome = Correct
+	case strings.Contains(message, "That's not the right answer"):
+		r.Outcome = Incorrect
+
+		if strings.Contains(message, "too high") {
+			r.Hint = "too high"
+		} else if strings.Contains(message, "too low") {
+			r.Hint = "too low"
+		}
+	case strings.Contains(message, "You gave an answer too recently"):
+		r.Outcome = TooRecent
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		r.Outcome = AlreadySolved
+	}
+
+	if match := leftRE.FindStringSubmatch(message); match != nil {
+		minutes, _ := strconv.Atoi(match[1])
+		seconds, _ := strconv.Atoi(match[2])
+		r.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if match := waitRE.FindStringSubmatch(message); match != nil {
+		minutes := 1
+		if match[1] != "one" {
+			minutes, _ = strconv.Atoi(match[1])
+		}
+
+		r.Wait = time.Duration(minutes) * time.Minute
+	}
+
+	return r
+}
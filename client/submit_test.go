@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Wraps a message the way the site does, inside the page's main article
+func page(message string) string {
+	return "<!DOCTYPE html><html><body><main>\n<article><p>" + message + "</p></article>\n</main></body></html>"
+}
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		page    string
+		outcome Outcome
+		hint    string
+		wait    time.Duration
+	}{
+		{
+			name:    "correct",
+			page:    page(`That's the right answer!  You are <span class="day-success">one gold star</span> closer to finding the lost keys. <a href="/2025/day/1#part2">[Continue to Part Two]</a>`),
+			outcome: Correct,
+		},
+		{
+			name:    "too high",
+			page:    page(`That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data.  Please wait one minute before trying again. <a href="/2025/day/1">[Return to Day 1]</a>`),
+			outcome: Incorrect,
+			hint:    "too high",
+			wait:    time.Minute,
+		},
+		{
+			name:    "too low",
+			page:    page(`That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again.`),
+			outcome: Incorrect,
+			hint:    "too low",
+			wait:    5 * time.Minute,
+		},
+		{
+			name:    "wrong without a hint",
+			page:    page(`That's not the right answer.  If you're stuck, there are some general tips on the <a href="/2025/about">about page</a>.  Please wait one minute before trying again.`),
+			outcome: Incorrect,
+			wait:    time.Minute,
+		},
+		{
+			name:    "too recent",
+			page:    page(`You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 20s left to wait. <a href="/2025/day/1">[Return to Day 1]</a>`),
+			outcome: TooRecent,
+			wait:    80 * time.Second,
+		},
+		{
+			name:    "too recent, seconds only",
+			page:    page(`You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 37s left to wait.`),
+			outcome: TooRecent,
+			wait:    37 * time.Second,
+		},
+		{
+			name:    "wrong level",
+			page:    page(`You don't seem to be solving the right level.  Did you already complete it? <a href="/2025/day/1">[Return to Day 1]</a>`),
+			outcome: AlreadySolved,
+		},
+		{
+			name:    "something else",
+			page:    "<html><body>Something went wrong</body></html>",
+			outcome: Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := ParseResponse(tt.page)
+
+			if r.Outcome != tt.outcome || r.Hint != tt.hint || r.Wait != tt.wait {
+				t.Errorf("got outcome %q, hint %q, wait %v; want %q, %q, %v", r.Outcome, r.Hint, r.Wait, tt.outcome, tt.hint, tt.wait)
+			}
+
+			if strings.Contains(r.Message, "<") {
+				t.Errorf("message still has HTML in it: %q", r.Message)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	now := time.Date(2025, 12, 1, 6, 0, 0, 0, time.UTC)
+
+	log := &AnswerLog{Submissions: []Submission{
+		{Day: 1, Part: 1, Answer: "1000", Outcome: Incorrect, Hint: "too high"},
+		{Day: 1, Part: 1, Answer: "500", Outcome: Incorrect, Hint: "too low"},
+		{Day: 1, Part: 1, Answer: "700", Outcome: Incorrect},
+		{Day: 2, Part: 1, Answer: "42", Outcome: Correct},
+		{Day: 3, Part: 2, Answer: "abc", Outcome: Incorrect, Hint: "too low"},
+	}}
+
+	tests := []struct {
+		name   string
+		day    int
+		part   int
+		answer string
+		ok     bool
+	}{
+		{"between the bounds", 1, 1, "800", true},
+		{"at the too high bound", 1, 1, "1000", false},
+		{"over the too high bound", 1, 1, "1500", false},
+		{"at the too low bound", 1, 1, "500", false},
+		{"under the too low bound", 1, 1, "12", false},
+		{"already rejected", 1, 1, "700", false},
+		{"bounds are per part", 1, 2, "1500", true},
+		{"already solved with it", 2, 1, "42", false},
+		{"already solved with something else", 2, 1, "43", false},
+		{"other part of a solved day", 2, 2, "42", true},
+		{"bounds need numbers", 3, 2, "abd", true},
+		{"rejected text", 3, 2, "abc", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := log.Check(tt.day, tt.part, tt.answer, now)
+
+			if tt.ok && err != nil {
+				t.Errorf("refused %s: %v", tt.answer, err)
+			} else if !tt.ok && err == nil {
+				t.Errorf("allowed %s", tt.answer)
+			}
+		})
+	}
+
+	t.Run("cooldown", func(t *testing.T) {
+		cooling := &AnswerLog{NextAllowed: now.Add(30 * time.Second)}
+
+		if err := cooling.Check(1, 1, "800", now); err == nil {
+			t.Error("allowed an answer during the cooldown")
+		}
+
+		if err := cooling.Check(1, 1, "800", now.Add(time.Minute)); err != nil {
+			t.Errorf("refused an answer after the cooldown: %v", err)
+		}
+	})
+}
+
+func TestSubmitChecked(t *testing.T) {
+	site := newFakeSite(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.PostFormValue("answer") {
+		case "42":
+			w.Write([]byte(page("That's the right answer!  You are one gold star closer.")))
+		case "11":
+			w.Write([]byte(page("You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 37s left to wait.")))
+		default:
+			w.Write([]byte(page("That's not the right answer; your answer is too low.  Please wait one minute before trying again.")))
+		}
+	})
+	c := site.client()
+	log := &AnswerLog{}
+
+	resp, err := SubmitChecked(context.Background(), c, log, 1, 2, "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Outcome != Incorrect || resp.Hint != "too low" {
+		t.Errorf("got %+v, want an incorrect, too low response", resp)
+	}
+
+	seen := site.requests()
+	if len(seen) != 1 {
+		t.Fatalf("got %d requests, want 1", len(seen))
+	}
+
+	if req := seen[0]; req.method != http.MethodPost || req.path != "/2025/day/1/answer" || req.form["level"] != "2" || req.form["answer"] != "10" {
+		t.Errorf("sent %s %s %v, want POST /2025/day/1/answer with level 2 and answer 10", req.method, req.path, req.form)
+	}
+
+	if len(log.Submissions) != 1 || log.NextAllowed.IsZero() {
+		t.Errorf("log has %d submissions and next allowed %v, want the rejection and its cooldown", len(log.Submissions), log.NextAllowed)
+	}
+
+	// The cooldown (and the too low hint) stop anything else going out
+	if _, err := SubmitChecked(context.Background(), c, log, 1, 2, "42"); err == nil {
+		t.Error("submitted during the cooldown")
+	}
+
+	log.NextAllowed = time.Time{}
+
+	if _, err := SubmitChecked(context.Background(), c, log, 1, 2, "5"); err == nil {
+		t.Error("submitted an answer ruled out by the too low hint")
+	}
+
+	if n := len(site.requests()); n != 1 {
+		t.Errorf("refused answers still made %d requests", n-1)
+	}
+
+	// Answers given too recently aren't a verdict, so they aren't logged, but the wait still is
+	if resp, err := SubmitChecked(context.Background(), c, log, 1, 2, "11"); err != nil || resp.Outcome != TooRecent {
+		t.Fatalf("got %+v, %v, want a too recent response", resp, err)
+	}
+
+	if len(log.Submissions) != 1 || log.NextAllowed.IsZero() {
+		t.Errorf("log has %d submissions and next allowed %v after a too recent response", len(log.Submissions), log.NextAllowed)
+	}
+
+	log.NextAllowed = time.Time{}
+
+	if resp, err := SubmitChecked(context.Background(), c, log, 1, 2, "42"); err != nil || resp.Outcome != Correct {
+		t.Fatalf("got %+v, %v, want a correct response", resp, err)
+	}
+
+	if answer, solved := log.Solved(1, 2); !solved || answer != "42" {
+		t.Errorf("part not solved with 42 after a correct response")
+	}
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/digdon/2025aoc/solver"
+)
+
+func benchCommand(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	year := fs.Int("year", solver.DefaultYear, "event year")
+	daySpec := fs.String("day", "all", "day(s) to benchmark: 7, 1-5, 1,3,5 or all")
+	part := fs.Int("part", 0, "part to benchmark (1 or 2); 0 runs both")
+	inputPath := fs.String("input", "inputs", "input file, - for stdin, or a directory holding <year>/dayNN.txt files")
+	benchTime := fs.Duration("benchtime", time.Second, "approximate run time for each variant")
+	format := fs.String("format", "text", "output format: text (a table per part), json (one object per line) or csv")
+	fs.Parse(args)
+
+	days, err := parseDays(*year, *daySpec)
+	if err != nil {
+		return err
+	}
+
+	parts, err := selectParts(*part)
+	if err != nil {
+		return err
+	}
+
+	var out resultWriter
+
+	if *format != "text" {
+		if out, err = newResultWriter(*format, os.Stdout); err != nil {
+			return err
+		}
+	}
+
+	commit := buildCommit()
+
+	for _, day := range days {
+		lines, err := loadInput(*inputPath, *year, day, len(days) > 1)
+		if err != nil {
+			return err
+		}
+
+		s, err := solver.Lookup(*year, day)
+		if err != nil {
+			return err
+		}
+
+		parseTime, _, _, err := measure(func() error { return s.Parse(lines) })
+		if err != nil {
+			return fmt.Errorf("day %d: %w", day, err)
+		}
+
+		for _, part := range parts {
+			results, err := benchPart(s, *year, day, part, *benchTime)
+			if err != nil {
+				return fmt.Errorf("day %d part %d: %w", day, part, err)
+			}
+
+			if out == nil {
+				if err := printBenchTable(day, part, results); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			for _, result := range results {
+				result.ParseTime = parseTime
+				result.Commit = commit
+
+				if err := out.Write(result); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if out != nil {
+		return out.Flush()
+	}
+
+	return nil
+}
+
+// Benchmarks the main implementation of a part along with any alternate variants, making sure they
+// all come up with the same answer
+func benchPart(s solver.Solver, year, day, part int, benchTime time.Duration) ([]Result, error) {
+	variants := []solver.Variant{{Name: "default", Part: part, Solve: func() (any, error) { return solver.Part(s, part) }}}
+	variants = append(variants, solver.Variants(s, part)...)
+
+	expected, err := variants[0].Solve()
+	if errors.Is(err, solver.ErrNoSolution) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	results := []Result{}
+
+	for _, v := range variants {
+		answer, err := v.Solve()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", v.Name, err)
+		}
+
+		if fmt.Sprint(answer) != fmt.Sprint(expected) {
+			return nil, fmt.Errorf("variant %q disagrees: got %v, want %v", v.Name, answer, expected)
+		}
+
+		solveTime, allocs, bytes := timeVariant(v, benchTime)
+
+		results = append(results, Result{
+			Year:      year,
+			Day:       day,
+			Part:      part,
+			Variant:   v.Name,
+			Answer:    fmt.Sprint(answer),
+			SolveTime: solveTime,
+			Allocs:    allocs,
+			Bytes:     bytes,
+		})
+	}
+
+	return results, nil
+}
+
+// Runs a variant over and over for roughly benchTime, returning the time, allocations and bytes per run. Like
+// go test's benchmarks, it starts with a single run and grows the count until a batch takes long enough.
+func timeVariant(v solver.Variant, benchTime time.Duration) (time.Duration, uint64, uint64) {
+	runs := 1
+
+	for {
+		elapsed, allocs, bytes, _ := measure(func() error {
+			for range runs {
+				v.Solve()
+			}
+			return nil
+		})
+
+		if elapsed >= benchTime || runs >= 1_000_000_000 {
+			n := uint64(runs)
+			return elapsed / time.Duration(runs), allocs / n, bytes / n
+		}
+
+		// Aim a little past the target, without growing by more than 100x on a single noisy batch
+		perRun := max(elapsed/time.Duration(runs), 1)
+		next := int(float64(benchTime/perRun) * 1.2)
+		runs = min(max(next, runs+1), runs*100)
+	}
+}
+
+// Prints the benchmark results for a part, with the speedup of each variant relative to the default
+func printBenchTable(day, part int, results []Result) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	fmt.Printf("Day %d part %d\n", day, part)
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "variant\tanswer\tns/op\tB/op\tallocs/op\tspeedup\t")
+
+	baseline := float64(max(results[0].SolveTime, 1))
+
+	for _, r := range results {
+		speedup := baseline / float64(max(r.SolveTime, 1))
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%.2fx\t\n", r.Variant, r.Answer, r.SolveTime.Nanoseconds(), r.Bytes, r.Allocs, speedup)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,17 @@
+package main
+
+// Every day registers itself with the solver package when imported
+import (
+	_ "github.com/digdon/2025aoc/2025/day_01"
+	_ "github.com/digdon/2025aoc/2025/day_02"
+	_ "github.com/digdon/2025aoc/2025/day_03"
+	_ "github.com/digdon/2025aoc/2025/day_04"
+	_ "github.com/digdon/2025aoc/2025/day_05"
+	_ "github.com/digdon/2025aoc/2025/day_06"
+	_ "github.com/digdon/2025aoc/2025/day_07"
+	_ "github.com/digdon/2025aoc/2025/day_08"
+	_ "github.com/digdon/2025aoc/2025/day_09"
+	_ "github.com/digdon/2025aoc/2025/day_10"
+	_ "github.com/digdon/2025aoc/2025/day_11"
+	_ "github.com/digdon/2025aoc/2025/day_12"
+)
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	day01 "github.com/digdon/2025aoc/2025/day_01"
+)
+
+// Prints every rotation of the 2025 day 1 dials, showing where they land on or pass over 0
+func dialCommand(args []string) error {
+	fs := flag.NewFlagSet("dial", flag.ExitOnError)
+	inputPath := fs.String("input", "inputs", "input file, - for stdin, or a directory holding <year>/dayNN.txt files")
+	format := fs.String("format", "table", "output format: table or json (one object per line)")
+	hits := fs.Bool("hits", false, "only show rotations that land on or pass over 0")
+	fs.Parse(args)
+
+	if *format != "table" && *format != "json" {
+		return fmt.Errorf("unknown output format %q (want table or json)", *format)
+	}
+
+	lines, err := loadInput(*inputPath, 2025, 1, false)
+	if err != nil {
+		return err
+	}
+
+	s := &day01.Solver{}
+	if err := s.Parse(lines); err != nil {
+		return err
+	}
+
+	events, err := s.Events()
+	if err != nil {
+		return err
+	}
+
+	if *format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+
+		for _, e := range events {
+			if *hits && !e.Landed && e.Passes == 0 {
+				continue
+			}
+
+			if err := enc.Encode(e); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "line\tdial\trotation\tstart\tend\tlanded\tpasses\tpart 1\tpart 2\t")
+
+	// Running totals, so the point where a count goes wrong is easy to find
+	part1, part2 := 0, 0
+
+	for _, e := range events {
+		landed := ""
+		if e.Landed {
+			part1++
+			part2++
+			landed = "yes"
+		}
+
+		part2 += e.Passes
+
+		if *hits && !e.Landed && e.Passes == 0 {
+			continue
+		}
+
+		fmt.Fprintf(tw, "%d\t%s\t%s%d\t%d\t%d\t%s\t%d\t%d\t%d\t\n", e.Line, e.Dial, e.Direction, e.Distance, e.Start, e.End, landed, e.Passes, part1, part2)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	counts, err := s.Counts()
+	if err != nil {
+		return err
+	}
+
+	// Only programs with named dials need a breakdown
+	if len(counts) == 1 && counts[0].Name == "" {
+		return nil
+	}
+
+	fmt.Println()
+	tw = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "dial\tlanded\tpasses\tpart 1\tpart 2\t")
+
+	for _, c := range counts {
+		name := c.Name
+		if name == "" {
+			name = "(unnamed)"
+		}
+
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t\n", name, c.Landed, c.Passed, c.Landed, c.Total())
+	}
+
+	fmt.Fprintf(tw, "total\t\t\t%d\t%d\t\n", part1, part2)
+
+	return tw.Flush()
+}
+
+// Works backwards from a day 1 password to the shortest list of rotations that produces it
+func crackCommand(args []string) error {
+	fs := flag.NewFlagSet("crack", flag.ExitOnError)
+	part1 := fs.Int("part1", 0, "times the dial should finish a rotation on the target")
+	part2 := fs.Int("part2", 0, "times the dial should reach the target in all, counting passes")
+	size := fs.Int("size", 100, "number of positions on the dial")
+	start := fs.Int("start", 50, "position the dial starts at")
+	target := fs.Int("target", 0, "position to count")
+	fs.Parse(args)
+
+	rotations, err := day01.FindRotations(*size, *start, *target, *part1, *part2)
+	if err != nil {
+		return err
+	}
+
+	// Replay the rotations, so the answer never goes out unchecked
+	dial, err := day01.NewDial(*size, *start, *target)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range rotations {
+		if _, err := dial.Turn(r.Dir, r.Count); err != nil {
+			return err
+		}
+
+		fmt.Println(r)
+	}
+
+	marks := dial.Marks(*target)
+	if marks.Landed != *part1 || marks.Total() != *part2 {
+		return fmt.Errorf("replaying the rotations gives part 1 %d and part 2 %d", marks.Landed, marks.Total())
+	}
+
+	log.Printf("replaying %d rotation(s) gives part 1 %d and part 2 %d", len(rotations), marks.Landed, marks.Total())
+
+	return nil
+}
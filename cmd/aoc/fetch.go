@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/digdon/2025aoc/client"
+)
+
+func fetchCommand(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	year := fs.Int("year", 0, "event year (defaults to the config file's year)")
+	daySpec := fs.String("day", "all", "day(s) to fetch: 7, 1-5, 1,3,5 or all")
+	inputDir := fs.String("inputs", "inputs", "directory to cache the inputs in, under a subdirectory per year")
+	configPath := fs.String("config", client.DefaultConfigPath(), "config file holding the session cookie, base URL and contact details")
+	fs.Parse(args)
+
+	cfg, err := client.LoadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+
+	if *year != 0 {
+		cfg.Year = *year
+	}
+
+	days, err := parseDays(cfg.Year, *daySpec)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	c := client.New(cfg)
+
+	for _, day := range days {
+		path, fetched, err := client.FetchInput(ctx, c, yearDir(*inputDir, cfg.Year), day)
+		if err != nil {
+			return fmt.Errorf("day %d: %w", day, err)
+		}
+
+		if fetched {
+			fmt.Printf("Day %d: downloaded to %s\n", day, path)
+		} else {
+			fmt.Printf("Day %d: already cached in %s\n", day, path)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"crypto/sha256"
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/solver"
+)
+
+// Characters that mean something to at least one of the parsers, so mutations are likely to reach the
+// interesting parts of them
+const fuzzAlphabet = "0123456789-,:[](){}#=.@^S* \txyz"
+
+// A panic caught while parsing a mutated input
+type crasher struct {
+	day   int
+	text  string
+	panic any
+	stack []byte
+}
+
+// A quick sweep of every day's parser with random mutations. For coverage-guided fuzzing of a single day,
+// with a saved corpus, use its FuzzParse target instead: go test -fuzz=FuzzParse ./2025/day_07
+func fuzzCommand(args []string) error {
+	fs := flag.NewFlagSet("fuzz", flag.ExitOnError)
+	year := fs.Int("year", solver.DefaultYear, "event year")
+	daySpec := fs.String("day", "all", "day(s) to fuzz: 7, 1-5, 1,3,5 or all")
+	duration := fs.Duration("duration", 10*time.Second, "how long to fuzz each day for")
+	seed := fs.Uint64("seed", 0, "random seed; 0 picks one from the clock")
+	crashDir := fs.String("crashers", filepath.Join("inputs", "crashers"), "directory to write panicking inputs to")
+	fs.Parse(args)
+
+	days, err := parseDays(*year, *daySpec)
+	if err != nil {
+		return err
+	}
+
+	if *seed == 0 {
+		*seed = uint64(time.Now().UnixNano())
+	}
+
+	fmt.Printf("seed %d\n", *seed)
+
+	rng := rand.New(rand.NewPCG(*seed, 0))
+	failed := 0
+
+	for _, day := range days {
+		// The corpus starts with the worked examples, and grows with every mutation that parses cleanly
+		corpus := []string{}
+		for _, example := range solver.Examples(*year, day) {
+			corpus = append(corpus, example.Input)
+		}
+
+		if len(corpus) == 0 {
+			corpus = append(corpus, "")
+		}
+
+		runs := 0
+		var found *crasher
+
+		for deadline := time.Now().Add(*duration); time.Now().Before(deadline); runs++ {
+			text := mutate(rng, corpus[rng.IntN(len(corpus))])
+
+			ok, crash := fuzzParse(*year, day, text)
+			if crash != nil {
+				found = crash
+				break
+			}
+
+			if ok && len(corpus) < 1000 {
+				corpus = append(corpus, text)
+			}
+		}
+
+		if found == nil {
+			fmt.Printf("ok   day %d: %d inputs\n", day, runs)
+			continue
+		}
+
+		failed++
+		path, err := saveCrasher(*crashDir, found)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("FAIL day %d: panic after %d inputs: %v (input saved to %s)\n%s\n", day, runs, found.panic, path, found.stack)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d days panicked", failed, len(days))
+	}
+
+	return nil
+}
+
+// Parses text with a fresh solver for the day, reporting whether it parsed cleanly or panicked
+func fuzzParse(year, day int, text string) (ok bool, crash *crasher) {
+	s, err := solver.Lookup(year, day)
+	if err != nil {
+		return false, nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			crash = &crasher{day: day, text: text, panic: r, stack: debug.Stack()}
+		}
+	}()
+
+	err = s.Parse(input.Split(text))
+
+	return err == nil, nil
+}
+
+// Applies a handful of random edits to an input
+func mutate(rng *rand.Rand, text string) string {
+	data := []byte(text)
+
+	for range 1 + rng.IntN(4) {
+		pos := 0
+		if len(data) > 0 {
+			pos = rng.IntN(len(data))
+		}
+
+		switch rng.IntN(7) {
+		case 0:
+			// Replace a byte
+			if len(data) > 0 {
+				data[pos] = fuzzAlphabet[rng.IntN(len(fuzzAlphabet))]
+			}
+		case 1:
+			// Insert a byte
+			data = append(data[:pos], append([]byte{fuzzAlphabet[rng.IntN(len(fuzzAlphabet))]}, data[pos:]...)...)
+		case 2:
+			// Delete a run of bytes
+			if len(data) > 0 {
+				data = append(data[:pos], data[min(len(data), pos+1+rng.IntN(8)):]...)
+			}
+		case 3:
+			// Truncate
+			data = data[:pos]
+		case 4:
+			// Blank out a line, or add a blank one
+			data = append(data[:pos], append([]byte("\n\n"), data[pos:]...)...)
+		case 5:
+			// Duplicate or drop a whole line
+			lines := strings.Split(string(data), "\n")
+			i := rng.IntN(len(lines))
+			if rng.IntN(2) == 0 {
+				lines = append(lines[:i], append([]string{lines[i]}, lines[i:]...)...)
+			} else {
+				lines = append(lines[:i], lines[i+1:]...)
+			}
+			data = []byte(strings.Join(lines, "\n"))
+		case 6:
+			// Huge or negative numbers
+			number := []string{"-1", "0", "99999999999999999999", "9223372036854775807", "-9223372036854775808"}[rng.IntN(5)]
+			data = append(data[:pos], append([]byte(number), data[pos:]...)...)
+		}
+	}
+
+	return string(data)
+}
+
+func saveCrasher(dir string, c *crasher) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(c.text))
+	path := filepath.Join(dir, fmt.Sprintf("day%02d-%x.txt", c.day, sum[:8]))
+
+	return path, os.WriteFile(path, []byte(c.text), 0o644)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/digdon/2025aoc/generate"
+	"github.com/digdon/2025aoc/solver"
+)
+
+func genCommand(args []string) error {
+	fs := flag.NewFlagSet("gen", flag.ExitOnError)
+	year := fs.Int("year", solver.DefaultYear, "event year")
+	day := fs.Int("day", 0, "day to generate an input for")
+	size := fs.Int("size", 0, "input size; what it counts depends on the day (0 for roughly the real input's size)")
+	seed := fs.Uint64("seed", 0, "random seed; 0 picks one from the clock")
+	output := fs.String("o", "-", "file to write the input to, or - for stdout")
+	list := fs.Bool("list", false, "list what size means for each day")
+	fs.Parse(args)
+
+	if *list {
+		for _, d := range generate.Days(*year) {
+			g, _ := generate.Lookup(*year, d)
+			fmt.Printf("day %2d: %s (default %d)\n", d, g.Size, g.Default)
+		}
+
+		return nil
+	}
+
+	g, err := generate.Lookup(*year, *day)
+	if err != nil {
+		return err
+	}
+
+	if *size == 0 {
+		*size = g.Default
+	}
+
+	if *seed == 0 {
+		*seed = uint64(time.Now().UnixNano())
+	}
+
+	lines, err := generate.Generate(*year, *day, *size, *seed)
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if *output != "-" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		out = f
+	}
+
+	// The seed goes to stderr, so a failing input can be recreated without getting in the way of a pipe
+	fmt.Fprintln(os.Stderr, "seed", strconv.FormatUint(*seed, 10))
+
+	w := bufio.NewWriter(out)
+	for _, line := range lines {
+		w.WriteString(line)
+		w.WriteByte('\n')
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/digdon/2025aoc/export"
+	"github.com/digdon/2025aoc/solver"
+	"github.com/digdon/2025aoc/visual"
+)
+
+// Solves a day again, outside of the timings, recording an animation for days that produce frames and
+// writing a snapshot for days that can draw their state. Returns the files written.
+func writeImages(dir string, year, day int, parts []int, lines []string) ([]string, error) {
+	s, err := solver.Lookup(year, day)
+	if err != nil {
+		return nil, err
+	}
+
+	source, animated := s.(visual.Source)
+	snapshotter, drawable := s.(export.Source)
+
+	if !animated && !drawable {
+		return nil, nil
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	animation := export.NewAnimation(4, 10)
+	if animated {
+		source.Visualise(animation.Sink())
+	}
+
+	if err := s.Parse(lines); err != nil {
+		return nil, err
+	}
+
+	for _, part := range parts {
+		if _, err := solver.Part(s, part); err != nil && !errors.Is(err, solver.ErrNoSolution) {
+			return nil, fmt.Errorf("part %d: %w", part, err)
+		}
+	}
+
+	written := []string{}
+
+	if animation.Len() > 0 {
+		path := filepath.Join(dir, fmt.Sprintf("%d-day%02d.gif", year, day))
+		if err := animation.Save(path); err != nil {
+			return written, err
+		}
+
+		written = append(written, path)
+	}
+
+	if drawable {
+		img, err := snapshotter.Snapshot()
+		if err != nil {
+			return written, err
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf("%d-day%02d.png", year, day))
+		if err := export.SavePNG(path, img); err != nil {
+			return written, err
+		}
+
+		written = append(written, path)
+	}
+
+	return written, nil
+}
@@ -0,0 +1,63 @@
+// Command aoc runs the Advent of Code 2025 solutions.
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+)
+
+var commands = map[string]func(args []string) error{
+	"bench":  benchCommand,
+	"crack":  crackCommand,
+	"dial":   dialCommand,
+	"fetch":  fetchCommand,
+	"fuzz":   fuzzCommand,
+	"gen":    genCommand,
+	"new":    newCommand,
+	"run":    runCommand,
+	"serve":  serveCommand,
+	"show":   showCommand,
+	"submit": submitCommand,
+	"verify": verifyCommand,
+}
+
+func main() {
+	log.SetFlags(0)
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	command, found := commands[os.Args[1]]
+	if !found {
+		usage()
+		os.Exit(2)
+	}
+
+	if err := command(os.Args[2:]); err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: aoc <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	fmt.Fprintln(os.Stderr, "  bench   benchmark the solutions, including any alternate implementations")
+	fmt.Fprintln(os.Stderr, "  crack   find the shortest list of day 1 rotations giving a password")
+	fmt.Fprintln(os.Stderr, "  dial    list every rotation of the 2025 day 1 dial")
+	fmt.Fprintln(os.Stderr, "  fetch   download puzzle inputs into the local cache")
+	fmt.Fprintln(os.Stderr, "  fuzz    feed mutated examples to the parsers, looking for panics")
+	fmt.Fprintln(os.Stderr, "  gen     generate a random puzzle input, for stress and differential testing")
+	fmt.Fprintln(os.Stderr, "  new     create a new day package from the template, registered with the runner")
+	fmt.Fprintln(os.Stderr, "  run     solve one or more days")
+	fmt.Fprintln(os.Stderr, "  serve   answer solve requests over HTTP, with JSON responses")
+	fmt.Fprintln(os.Stderr, "  show    watch a grid-based day solve in the terminal")
+	fmt.Fprintln(os.Stderr, "  submit  submit an answer, refusing ones already known to be wrong")
+	fmt.Fprintln(os.Stderr, "  verify  check answers against the worked examples and recorded personal answers")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Run 'aoc <command> -h' for command flags.")
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/digdon/2025aoc/solver"
+)
+
+//go:embed templates
+var templates embed.FS
+
+const modulePath = "github.com/digdon/2025aoc"
+
+func newCommand(args []string) error {
+	fs := flag.NewFlagSet("new", flag.ExitOnError)
+	year := fs.Int("year", solver.DefaultYear, "event year")
+	day := fs.Int("day", 0, "day to create")
+	title := fs.String("title", "", "puzzle title, for the README")
+	root := fs.String("root", ".", "repository root")
+	fs.Parse(args)
+
+	if *day < 1 || *day > 25 {
+		return errors.New("-day must be given, between 1 and 25")
+	}
+
+	dir := filepath.Join(*root, strconv.Itoa(*year), fmt.Sprintf("day_%02d", *day))
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("%s already exists", dir)
+	}
+
+	data := struct {
+		Year    int
+		Day     int
+		Package string
+		Title   string
+	}{*year, *day, fmt.Sprintf("day%02d", *day), *title}
+
+	source, err := render("day.go.tmpl", data)
+	if err != nil {
+		return err
+	}
+
+	if source, err = format.Source(source); err != nil {
+		return err
+	}
+
+	test, err := render("day_test.go.tmpl", data)
+	if err != nil {
+		return err
+	}
+
+	if test, err = format.Source(test); err != nil {
+		return err
+	}
+
+	readme, err := render("README.md.tmpl", data)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	files := []struct {
+		name     string
+		contents []byte
+	}{
+		{data.Package + ".go", source},
+		{data.Package + "_test.go", test},
+		{"example.txt", nil},
+		{"README.md", readme},
+	}
+
+	for _, f := range files {
+		if err := os.WriteFile(filepath.Join(dir, f.name), f.contents, 0o644); err != nil {
+			return err
+		}
+
+		fmt.Println("created", filepath.Join(dir, f.name))
+	}
+
+	daysFile := filepath.Join(*root, "cmd", "aoc", "days.go")
+	if err := addDayImport(daysFile, *year, *day); err != nil {
+		return err
+	}
+
+	fmt.Println("registered in", daysFile)
+
+	return nil
+}
+
+func render(name string, data any) ([]byte, error) {
+	tmpl, err := template.ParseFS(templates, "templates/"+name)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Adds the blank import for a day to days.go, keeping the imports in year and day order
+func addDayImport(path string, year, day int) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	newImport := fmt.Sprintf("\t_ %q", fmt.Sprintf("%s/%d/day_%02d", modulePath, year, day))
+
+	// Find the block of existing day imports
+	first, last := -1, -1
+
+	for i, line := range lines {
+		if strings.HasPrefix(line, "\t_ \""+modulePath+"/") && strings.Contains(line, "/day_") {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+
+	if first < 0 {
+		return fmt.Errorf("%s: no day imports found", path)
+	}
+
+	imports := slices.Clone(lines[first : last+1])
+	if slices.Contains(imports, newImport) {
+		return nil
+	}
+
+	imports = append(imports, newImport)
+	slices.Sort(imports)
+
+	lines = slices.Concat(lines[:first], imports, lines[last+1:])
+
+	return os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o644)
+}
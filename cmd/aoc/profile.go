@@ -0,0 +1,111 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+// Where to write each kind of profile. Empty paths are skipped.
+type profileConfig struct {
+	cpu      string
+	heap     string
+	allocs   string
+	trace    string
+	memRate  int
+	stoppers []func() error
+}
+
+func (pc *profileConfig) register(fs *flag.FlagSet) {
+	fs.StringVar(&pc.cpu, "cpuprofile", "", "write a CPU profile to this file")
+	fs.StringVar(&pc.heap, "memprofile", "", "write a heap profile to this file once solving is done")
+	fs.StringVar(&pc.allocs, "allocprofile", "", "write an allocation profile (everything allocated, not just what's live) to this file")
+	fs.StringVar(&pc.trace, "trace", "", "write a runtime execution trace to this file")
+	fs.IntVar(&pc.memRate, "memprofilerate", 0, "bytes between memory profile samples; 1 records every allocation (0 keeps the runtime default)")
+}
+
+// Starts whichever profiles were asked for. Stop must be called to write them out.
+func (pc *profileConfig) start() error {
+	if pc.memRate > 0 {
+		runtime.MemProfileRate = pc.memRate
+	}
+
+	if pc.cpu != "" {
+		f, err := os.Create(pc.cpu)
+		if err != nil {
+			return err
+		}
+
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return err
+		}
+
+		pc.stoppers = append(pc.stoppers, func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		})
+	}
+
+	if pc.trace != "" {
+		f, err := os.Create(pc.trace)
+		if err != nil {
+			return err
+		}
+
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			return err
+		}
+
+		pc.stoppers = append(pc.stoppers, func() error {
+			trace.Stop()
+			return f.Close()
+		})
+	}
+
+	if pc.heap != "" {
+		pc.stoppers = append(pc.stoppers, func() error {
+			// Get up-to-date statistics on what's still live
+			runtime.GC()
+			return writeProfile("heap", pc.heap)
+		})
+	}
+
+	if pc.allocs != "" {
+		pc.stoppers = append(pc.stoppers, func() error {
+			return writeProfile("allocs", pc.allocs)
+		})
+	}
+
+	return nil
+}
+
+func (pc *profileConfig) stop() error {
+	var errs []error
+
+	for _, stop := range pc.stoppers {
+		errs = append(errs, stop())
+	}
+
+	pc.stoppers = nil
+
+	return errors.Join(errs...)
+}
+
+func writeProfile(name, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := pprof.Lookup(name).WriteTo(f, 0); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
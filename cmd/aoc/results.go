@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"time"
+)
+
+// Result is the record for one solved part, in a form that's easy to feed into other tools
+type Result struct {
+	Year      int           `json:"year"`
+	Day       int           `json:"day"`
+	Part      int           `json:"part"`
+	Variant   string        `json:"variant"`
+	Answer    string        `json:"answer"`
+	ParseTime time.Duration `json:"parse_ns"`
+	SolveTime time.Duration `json:"solve_ns"`
+	Allocs    uint64        `json:"allocs"`
+	Bytes     uint64        `json:"bytes"`
+	Commit    string        `json:"commit,omitempty"`
+}
+
+type resultWriter interface {
+	Write(r Result) error
+	Flush() error
+}
+
+func newResultWriter(format string, w io.Writer) (resultWriter, error) {
+	switch format {
+	case "text":
+		return &textWriter{w: w}, nil
+	case "json":
+		return &jsonWriter{enc: json.NewEncoder(w)}, nil
+	case "csv":
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	}
+
+	return nil, fmt.Errorf("unknown output format %q (want text, json or csv)", format)
+}
+
+// The original human-readable output: a header per day, then one line per part
+type textWriter struct {
+	w       io.Writer
+	lastDay int
+}
+
+func (tw *textWriter) Write(r Result) error {
+	if r.Day != tw.lastDay {
+		fmt.Fprintf(tw.w, "Day %d\n", r.Day)
+		fmt.Fprintf(tw.w, "Parse: %v\n", r.ParseTime)
+		tw.lastDay = r.Day
+	}
+
+	_, err := fmt.Fprintf(tw.w, "Part %d: %s (%v)\n", r.Part, r.Answer, r.SolveTime)
+	return err
+}
+
+func (tw *textWriter) Flush() error {
+	return nil
+}
+
+// One JSON object per line
+type jsonWriter struct {
+	enc *json.Encoder
+}
+
+func (jw *jsonWriter) Write(r Result) error {
+	return jw.enc.Encode(r)
+}
+
+func (jw *jsonWriter) Flush() error {
+	return nil
+}
+
+type csvWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (cw *csvWriter) Write(r Result) error {
+	if !cw.headerWritten {
+		cw.w.Write([]string{"year", "day", "part", "variant", "answer", "parse_ns", "solve_ns", "allocs", "bytes", "commit"})
+		cw.headerWritten = true
+	}
+
+	return cw.w.Write([]string{
+		strconv.Itoa(r.Year),
+		strconv.Itoa(r.Day),
+		strconv.Itoa(r.Part),
+		r.Variant,
+		r.Answer,
+		strconv.FormatInt(r.ParseTime.Nanoseconds(), 10),
+		strconv.FormatInt(r.SolveTime.Nanoseconds(), 10),
+		strconv.FormatUint(r.Allocs, 10),
+		strconv.FormatUint(r.Bytes, 10),
+		r.Commit,
+	})
+}
+
+func (cw *csvWriter) Flush() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// Runs f, returning how long it took along with the number of allocations and bytes allocated
+func measure(f func() error) (time.Duration, uint64, uint64, error) {
+	var before, after runtime.MemStats
+
+	runtime.ReadMemStats(&before)
+	begin := time.Now()
+	err := f()
+	elapsed := time.Since(begin)
+	runtime.ReadMemStats(&after)
+
+	return elapsed, after.Mallocs - before.Mallocs, after.TotalAlloc - before.TotalAlloc, err
+}
+
+// The commit the binary was built from, when it's available (ie, built with go build from a git checkout)
+func buildCommit() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	commit, dirty := "", false
+
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			commit = setting.Value
+		case "vcs.modified":
+			dirty = setting.Value == "true"
+		}
+	}
+
+	if commit != "" && dirty {
+		commit += "-dirty"
+	}
+
+	return commit
+}
@@ -0,0 +1,372 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/digdon/2025aoc/client"
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/parallel"
+	"github.com/digdon/2025aoc/solver"
+	"github.com/digdon/2025aoc/trace"
+)
+
+func runCommand(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	year := fs.Int("year", solver.DefaultYear, "event year")
+	daySpec := fs.String("day", "all", "day(s) to run: 7, 1-5, 1,3,5 or all")
+	part := fs.Int("part", 0, "part to run (1 or 2); 0 runs both")
+	inputPath := fs.String("input", "inputs", "input file, - for stdin, or a directory holding <year>/dayNN.txt files")
+	skipBad := fs.Bool("skip-bad", false, "report lines that fail to parse and solve with the rest")
+	format := fs.String("format", "text", "output format: text, json (one object per line) or csv")
+	workers := fs.Int("workers", 1, "number of days (and independent pieces within a day) to run at once; allocation counts are process-wide, so they're only exact with 1")
+	timeout := fs.Duration("timeout", 0, "give up on a day after this long (0 means no limit)")
+	repeat := fs.Int("repeat", 1, "parse and solve each day this many times (ie, to give a profile more samples); times are averaged")
+	verbose := fs.Bool("v", false, "log a summary of each solver's intermediate state to stderr")
+	imageDir := fs.String("images", "", "write PNG snapshots (and GIF animations) of the days that can draw themselves to this directory")
+	veryVerbose := fs.Bool("vv", false, "log each solver's intermediate state in full detail to stderr (implies -v)")
+	var profiles profileConfig
+	profiles.register(fs)
+	fs.Parse(args)
+
+	if *repeat < 1 {
+		return fmt.Errorf("invalid repeat count %d", *repeat)
+	}
+
+	days, err := parseDays(*year, *daySpec)
+	if err != nil {
+		return err
+	}
+
+	parts, err := selectParts(*part)
+	if err != nil {
+		return err
+	}
+
+	out, err := newResultWriter(*format, os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	parallel.SetWorkers(*workers)
+
+	if *verbose || *veryVerbose {
+		level := slog.LevelInfo
+		if *veryVerbose {
+			level = slog.LevelDebug
+		}
+
+		trace.SetHandler(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+			Level: level,
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				// Timestamps are just noise next to the solver timings
+				if a.Key == slog.TimeKey && len(groups) == 0 {
+					return slog.Attr{}
+				}
+				return a
+			},
+		}))
+	}
+
+	if err := profiles.start(); err != nil {
+		return err
+	}
+	defer profiles.stop()
+
+	// Ctrl-C stops any days that haven't started yet, and abandons the ones that are running
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	reports, _ := parallel.Map(ctx, *workers, len(days), func(ctx context.Context, i int) (dayReport, error) {
+		report := dayReport{day: days[i]}
+
+		// The input is kept for drawing, as stdin can only be read once
+		report.lines, report.err = loadInput(*inputPath, *year, days[i], len(days) > 1)
+		if report.err != nil {
+			return report, nil
+		}
+
+		report.results, report.err = parallel.WithTimeout(ctx, *timeout, func(ctx context.Context) ([]Result, error) {
+			return runDay(ctx, *year, days[i], parts, report.lines, *skipBad, *repeat)
+		})
+
+		return report, nil
+	})
+
+	// Days that never started due to cancellation come back empty
+	failed := 0
+
+	for i := range reports {
+		reports[i].day = days[i]
+
+		if reports[i].err == nil && reports[i].results == nil && ctx.Err() != nil {
+			reports[i].err = ctx.Err()
+		}
+
+		for _, result := range reports[i].results {
+			if err := out.Write(result); err != nil {
+				return err
+			}
+		}
+
+		if reports[i].err != nil {
+			log.Printf("day %d: %v", days[i], reports[i].err)
+			failed++
+		}
+	}
+
+	if err := out.Flush(); err != nil {
+		return err
+	}
+
+	if err := profiles.stop(); err != nil {
+		return err
+	}
+
+	if *format == "text" && len(days) > 1 {
+		printSummary(reports)
+	}
+
+	// Drawing is done as a separate run, so it can't affect the timings
+	if *imageDir != "" {
+		for _, report := range reports {
+			if report.err != nil {
+				continue
+			}
+
+			written, err := writeImages(*imageDir, *year, report.day, parts, report.lines)
+			if err != nil {
+				log.Printf("day %d: drawing: %v", report.day, err)
+				failed++
+			}
+
+			for _, path := range written {
+				log.Printf("day %d: wrote %s", report.day, path)
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d days failed", failed, len(days))
+	}
+
+	return nil
+}
+
+// Everything that happened while running a single day
+type dayReport struct {
+	day     int
+	lines   []string
+	results []Result
+	err     error
+}
+
+func runDay(ctx context.Context, year, day int, parts []int, lines []string, skipBad bool, repeat int) ([]Result, error) {
+	var results []Result
+
+	for run := range repeat {
+		runResults, err := solveDay(ctx, year, day, parts, lines, skipBad && run == 0, skipBad)
+		if err != nil {
+			return runResults, err
+		}
+
+		if results == nil {
+			results = runResults
+			continue
+		}
+
+		for i, r := range runResults {
+			results[i].ParseTime += r.ParseTime
+			results[i].SolveTime += r.SolveTime
+			results[i].Allocs += r.Allocs
+			results[i].Bytes += r.Bytes
+		}
+	}
+
+	// Report the average of all the runs
+	for i := range results {
+		results[i].ParseTime /= time.Duration(repeat)
+		results[i].SolveTime /= time.Duration(repeat)
+		results[i].Allocs /= uint64(repeat)
+		results[i].Bytes /= uint64(repeat)
+	}
+
+	return results, nil
+}
+
+// Parses and solves a day once, on a fresh solver. Skipped lines are only logged when asked, so that
+// repeated runs don't report them over and over.
+func solveDay(ctx context.Context, year, day int, parts []int, lines []string, logSkipped bool, skipBad bool) ([]Result, error) {
+	s, err := solver.Lookup(year, day)
+	if err != nil {
+		return nil, err
+	}
+
+	parseTime, _, _, err := measure(func() error { return s.Parse(lines) })
+	if err != nil {
+		var badLines input.Errors
+
+		if !skipBad || !errors.As(err, &badLines) {
+			return nil, err
+		}
+
+		for _, badLine := range badLines {
+			if logSkipped {
+				log.Printf("day %d: skipping %v", day, badLine)
+			}
+		}
+	}
+
+	commit := buildCommit()
+	results := []Result{}
+
+	for _, part := range parts {
+		var answer any
+
+		solveTime, allocs, bytes, err := measure(func() (err error) {
+			answer, err = solver.PartContext(ctx, s, part)
+			return err
+		})
+
+		if errors.Is(err, solver.ErrNoSolution) {
+			continue
+		} else if err != nil {
+			return results, fmt.Errorf("part %d: %w", part, err)
+		}
+
+		results = append(results, Result{
+			Year:      year,
+			Day:       day,
+			Part:      part,
+			Variant:   "default",
+			Answer:    fmt.Sprint(answer),
+			ParseTime: parseTime,
+			SolveTime: solveTime,
+			Allocs:    allocs,
+			Bytes:     bytes,
+			Commit:    commit,
+		})
+	}
+
+	return results, nil
+}
+
+// Prints a table with a line per day, covering the answers, timings and any failures
+func printSummary(reports []dayReport) {
+	fmt.Println()
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "day\tpart 1\tpart 2\tparse\tsolve\tstatus")
+
+	var totalTime time.Duration
+
+	for _, report := range reports {
+		answers := map[int]string{1: "-", 2: "-"}
+		var parseTime, solveTime time.Duration
+
+		for _, r := range report.results {
+			answers[r.Part] = r.Answer
+			parseTime = r.ParseTime
+			solveTime += r.SolveTime
+		}
+
+		status := "ok"
+		if report.err != nil {
+			status = report.err.Error()
+		}
+
+		totalTime += parseTime + solveTime
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%v\t%v\t%s\n", report.day, answers[1], answers[2], parseTime, solveTime, status)
+	}
+
+	fmt.Fprintf(tw, "total\t\t\t\t%v\t\n", totalTime)
+	tw.Flush()
+}
+
+// Turns the -part flag into the list of parts to run, where 0 means both
+func selectParts(part int) ([]int, error) {
+	switch part {
+	case 0:
+		return []int{1, 2}, nil
+	case 1, 2:
+		return []int{part}, nil
+	}
+
+	return nil, fmt.Errorf("invalid part %d", part)
+}
+
+// Turns a day specification (7, 1-5, 1,3,5 or all) into a list of a year's days
+func parseDays(year int, spec string) ([]int, error) {
+	if spec == "all" {
+		days := solver.Days(year)
+		if len(days) == 0 {
+			return nil, fmt.Errorf("no solvers registered for %d", year)
+		}
+
+		return days, nil
+	}
+
+	days := []int{}
+
+	for part := range strings.SplitSeq(spec, ",") {
+		var start, end int
+
+		if n, err := fmt.Sscanf(part, "%d-%d", &start, &end); err == nil && n == 2 {
+			// A range of days
+		} else if day, err := strconv.Atoi(part); err == nil {
+			start, end = day, day
+		} else {
+			return nil, fmt.Errorf("invalid day specification: %s", part)
+		}
+
+		for day := start; day <= end; day++ {
+			if !solver.Registered(year, day) {
+				return nil, fmt.Errorf("no solver registered for %d day %d", year, day)
+			}
+
+			days = append(days, day)
+		}
+	}
+
+	return days, nil
+}
+
+// Reads the input for a day. The path can be a file, - for stdin, or a directory with a subdirectory per year
+// holding dayNN.txt files. Files and stdin only make sense when a single day is being run.
+func loadInput(path string, year, day int, multiDay bool) ([]string, error) {
+	if path == "-" {
+		if multiDay {
+			return nil, errors.New("stdin input can only be used with a single day")
+		}
+
+		return input.Load(path)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		path = client.InputPath(yearDir(path, year), day)
+	} else if multiDay {
+		return nil, fmt.Errorf("input %s is a file, but more than one day was requested", path)
+	}
+
+	return input.Load(path)
+}
+
+// Personal inputs, answers and submissions are kept in a directory per year
+func yearDir(dir string, year int) string {
+	return filepath.Join(dir, strconv.Itoa(year))
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"time"
+
+	"github.com/digdon/2025aoc/server"
+)
+
+func serveCommand(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", "127.0.0.1:8080", "address to listen on")
+	maxBody := fs.Int64("max-body", 1<<20, "largest input accepted, in bytes")
+	timeout := fs.Duration("timeout", 30*time.Second, "longest a single request can spend solving")
+	concurrent := fs.Int("concurrent", runtime.GOMAXPROCS(0), "number of solves that can run at once; any more are turned away")
+	fs.Parse(args)
+
+	srv := &http.Server{
+		Addr: *addr,
+		Handler: server.New(server.Config{
+			MaxBody:       *maxBody,
+			Timeout:       *timeout,
+			MaxConcurrent: *concurrent,
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Ctrl-C lets the requests in progress finish before shutting down
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), *timeout)
+		defer cancel()
+
+		srv.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("listening on http://%s", *addr)
+
+	if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/digdon/2025aoc/solver"
+	"github.com/digdon/2025aoc/visual"
+)
+
+func showCommand(args []string) error {
+	fs := flag.NewFlagSet("show", flag.ExitOnError)
+	year := fs.Int("year", solver.DefaultYear, "event year")
+	day := fs.Int("day", 0, "day to visualise (4 and 7 of 2025 can be watched)")
+	part := fs.Int("part", 0, "part to run (1 or 2); 0 runs both")
+	inputPath := fs.String("input", "inputs", "input file, - for stdin, or a directory holding <year>/dayNN.txt files")
+	fps := fs.Int("fps", 10, "frames per second when animating; 0 plays as fast as possible")
+	step := fs.Bool("step", false, "wait for Enter after each frame")
+	colour := fs.String("colour", "auto", "use ANSI colours: auto (only on a terminal), always or never")
+	fs.Parse(args)
+
+	parts, err := selectParts(*part)
+	if err != nil {
+		return err
+	}
+
+	useColour, err := wantColour(*colour)
+	if err != nil {
+		return err
+	}
+
+	if *step && *inputPath == "-" {
+		return fmt.Errorf("-step reads from stdin, so it can't be used with -input -")
+	}
+
+	s, err := solver.Lookup(*year, *day)
+	if err != nil {
+		return err
+	}
+
+	source, ok := s.(visual.Source)
+	if !ok {
+		return fmt.Errorf("day %d has nothing to show", *day)
+	}
+
+	lines, err := loadInput(*inputPath, *year, *day, false)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Parse(lines); err != nil {
+		return err
+	}
+
+	player := visual.NewPlayer(os.Stdout, os.Stdin, *fps, *step, useColour)
+	source.Visualise(player.Sink())
+
+	for _, part := range parts {
+		before := player.Frames()
+
+		answer, err := solver.Part(s, part)
+		if err != nil {
+			return fmt.Errorf("part %d: %w", part, err)
+		}
+
+		fmt.Printf("Part %d: %v (%d frames)\n", part, answer, player.Frames()-before)
+	}
+
+	return nil
+}
+
+func wantColour(setting string) (bool, error) {
+	switch setting {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "auto":
+		// Only colour a real terminal, and respect https://no-color.org
+		info, err := os.Stdout.Stat()
+		if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+			return false, nil
+		}
+
+		return os.Getenv("NO_COLOR") == "" && os.Getenv("TERM") != "dumb", nil
+	}
+
+	return false, fmt.Errorf("invalid colour setting %q (auto, always or never)", setting)
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	"github.com/digdon/2025aoc/client"
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/solver"
+)
+
+func submitCommand(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	year := fs.Int("year", 0, "event year (defaults to the config file's year)")
+	day := fs.Int("day", 0, "day to submit an answer for")
+	part := fs.Int("part", 0, "part to submit an answer for (1 or 2)")
+	answer := fs.String("answer", "", "answer to submit; solves the cached input if not given")
+	inputDir := fs.String("inputs", "inputs", "directory holding the cached <year>/dayNN.txt inputs, answers.json and submissions.json")
+	configPath := fs.String("config", client.DefaultConfigPath(), "config file holding the session cookie, base URL and contact details")
+	fs.Parse(args)
+
+	if *day < 1 || *day > 25 {
+		return errors.New("-day must be given, between 1 and 25")
+	}
+
+	if *part != 1 && *part != 2 {
+		return errors.New("-part must be 1 or 2")
+	}
+
+	cfg, err := client.LoadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+
+	if *year != 0 {
+		cfg.Year = *year
+	}
+
+	dir := yearDir(*inputDir, cfg.Year)
+
+	if *answer == "" {
+		solved, err := solveForSubmit(client.InputPath(dir, *day), cfg.Year, *day, *part)
+		if err != nil {
+			return err
+		}
+
+		*answer = solved
+		fmt.Printf("Day %d part %d: solved %s\n", *day, *part, *answer)
+	}
+
+	logPath := filepath.Join(dir, "submissions.json")
+
+	log, err := client.LoadAnswerLog(logPath)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	resp, err := client.SubmitChecked(ctx, client.New(cfg), log, *day, *part, *answer)
+	if err != nil {
+		return err
+	}
+
+	// Save the log before anything else, so a failure below can't lose track of the submission
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	if err := log.Save(logPath); err != nil {
+		return err
+	}
+
+	if resp.Outcome == client.TooRecent {
+		fmt.Printf("Day %d part %d: %s wasn't checked, an answer was given too recently", *day, *part, *answer)
+	} else {
+		fmt.Printf("Day %d part %d: %s is %s", *day, *part, *answer, resp.Outcome)
+	}
+	if resp.Hint != "" {
+		fmt.Printf(" (%s)", resp.Hint)
+	}
+	if resp.Wait > 0 {
+		fmt.Printf(", wait %v before the next answer", resp.Wait)
+	}
+	fmt.Println()
+
+	if resp.Outcome == client.Unknown {
+		fmt.Println(resp.Message)
+	}
+
+	// A right answer becomes a regression check for verify
+	if resp.Outcome == client.Correct {
+		answersPath := filepath.Join(dir, "answers.json")
+
+		answers, err := loadAnswers(answersPath)
+		if err != nil {
+			return err
+		}
+
+		if answers[*day] == nil {
+			answers[*day] = map[int]string{}
+		}
+		answers[*day][*part] = *answer
+
+		return saveAnswers(answersPath, answers)
+	}
+
+	return nil
+}
+
+func solveForSubmit(path string, year, day, part int) (string, error) {
+	lines, err := input.Load(path)
+	if err != nil {
+		return "", err
+	}
+
+	s, err := solver.Lookup(year, day)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.Parse(lines); err != nil {
+		return "", err
+	}
+
+	answer, err := solver.Part(s, part)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprint(answer), nil
+}
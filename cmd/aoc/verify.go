@@ -0,0 +1,193 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/digdon/2025aoc/client"
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/solver"
+)
+
+// A single regression check: an input plus the answers it's expected to produce
+type verifyCase struct {
+	name      string
+	day       int
+	newSolver func() (solver.Solver, error)
+	lines     []string
+	expected  map[int]any
+	personal  bool
+}
+
+// Recorded answers for personal inputs, keyed by day and then part
+type answerLog map[int]map[int]string
+
+func verifyCommand(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	year := fs.Int("year", solver.DefaultYear, "event year")
+	daySpec := fs.String("day", "all", "day(s) to verify: 7, 1-5, 1,3,5 or all")
+	inputDir := fs.String("inputs", "inputs", "directory holding personal <year>/dayNN.txt inputs and their answers.json")
+	record := fs.Bool("record", false, "record the current answers for the personal inputs instead of checking them")
+	fs.Parse(args)
+
+	days, err := parseDays(*year, *daySpec)
+	if err != nil {
+		return err
+	}
+
+	answersPath := filepath.Join(yearDir(*inputDir, *year), "answers.json")
+	answers, err := loadAnswers(answersPath)
+	if err != nil {
+		return err
+	}
+
+	cases, err := verifyCases(*year, days, *inputDir, answers)
+	if err != nil {
+		return err
+	}
+
+	checks, failures := 0, 0
+
+	for _, vc := range cases {
+		s, err := vc.newSolver()
+		if err != nil {
+			return err
+		}
+
+		if err := s.Parse(vc.lines); err != nil {
+			fmt.Printf("FAIL %s: %v\n", vc.name, err)
+			checks++
+			failures++
+			continue
+		}
+
+		for _, part := range []int{1, 2} {
+			answer, err := solver.Part(s, part)
+
+			if errors.Is(err, solver.ErrNoSolution) {
+				continue
+			}
+
+			if *record && vc.personal {
+				if err != nil {
+					return fmt.Errorf("%s part %d: %w", vc.name, part, err)
+				}
+
+				if answers[vc.day] == nil {
+					answers[vc.day] = map[int]string{}
+				}
+
+				answers[vc.day][part] = fmt.Sprint(answer)
+				fmt.Printf("rec  %s part %d: %v\n", vc.name, part, answer)
+				continue
+			}
+
+			want := vc.expected[part]
+			if want == nil {
+				continue
+			}
+
+			checks++
+
+			if err != nil {
+				fmt.Printf("FAIL %s part %d: %v\n", vc.name, part, err)
+				failures++
+			} else if fmt.Sprint(answer) != fmt.Sprint(want) {
+				fmt.Printf("FAIL %s part %d: got %v, want %v\n", vc.name, part, answer, want)
+				failures++
+			} else {
+				fmt.Printf("ok   %s part %d: %v\n", vc.name, part, answer)
+			}
+		}
+	}
+
+	if *record {
+		return saveAnswers(answersPath, answers)
+	}
+
+	if failures > 0 {
+		return fmt.Errorf("%d of %d checks failed", failures, checks)
+	}
+
+	fmt.Printf("all %d checks passed\n", checks)
+
+	return nil
+}
+
+// Builds the checks for a year's days: every worked example, plus the personal input for any day that has one
+func verifyCases(year int, days []int, inputDir string, answers answerLog) ([]verifyCase, error) {
+	cases := []verifyCase{}
+
+	for _, day := range days {
+		// The worked examples from the puzzle descriptions
+		for _, example := range solver.Examples(year, day) {
+			name := fmt.Sprintf("day %d example", day)
+			if example.Name != "" {
+				name += " (" + example.Name + ")"
+			}
+
+			cases = append(cases, verifyCase{
+				name:      name,
+				day:       day,
+				newSolver: func() (solver.Solver, error) { return example.NewFor(year, day) },
+				lines:     input.Split(example.Input),
+				expected:  map[int]any{1: example.Part1, 2: example.Part2},
+			})
+		}
+
+		// Personal input, if there is one
+		lines, err := input.Load(client.InputPath(yearDir(inputDir, year), day))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		expected := map[int]any{}
+
+		for part, answer := range answers[day] {
+			expected[part] = answer
+		}
+
+		cases = append(cases, verifyCase{
+			name:      fmt.Sprintf("day %d input", day),
+			day:       day,
+			newSolver: func() (solver.Solver, error) { return solver.Lookup(year, day) },
+			lines:     lines,
+			expected:  expected,
+			personal:  true,
+		})
+	}
+
+	return cases, nil
+}
+
+func loadAnswers(path string) (answerLog, error) {
+	answers := answerLog{}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return answers, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &answers); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return answers, nil
+}
+
+func saveAnswers(path string, answers answerLog) error {
+	data, err := json.MarshalIndent(answers, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
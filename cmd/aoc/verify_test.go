@@ -0,0 +1,58 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/digdon/2025aoc/solver"
+)
+
+// Personal inputs aren't committed, so their checks only run where they've been fetched
+var testInputs = flag.String("inputs", filepath.Join("..", "..", "inputs"), "directory holding personal <year>/dayNN.txt inputs and their answers.json")
+
+// Every worked example, and every personal input with a recorded answer, has to keep giving the same answers
+func TestAnswers(t *testing.T) {
+	for _, year := range solver.Years() {
+		answers, err := loadAnswers(filepath.Join(yearDir(*testInputs, year), "answers.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		cases, err := verifyCases(year, solver.Days(year), *testInputs, answers)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, vc := range cases {
+			t.Run(fmt.Sprintf("%d %s", year, vc.name), func(t *testing.T) {
+				s, err := vc.newSolver()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if err := s.Parse(vc.lines); err != nil {
+					t.Fatalf("parse: %v", err)
+				}
+
+				for _, part := range []int{1, 2} {
+					want := vc.expected[part]
+					if want == nil {
+						continue
+					}
+
+					got, err := solver.Part(s, part)
+					if errors.Is(err, solver.ErrNoSolution) {
+						continue
+					} else if err != nil {
+						t.Errorf("part %d: %v", part, err)
+					} else if fmt.Sprint(got) != fmt.Sprint(want) {
+						t.Errorf("part %d: got %v, want %v", part, got, want)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package export
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"os"
+
+	"github.com/digdon/2025aoc/visual"
+)
+
+// The terminal colours, as a GIF palette. Index 0 is the background.
+var palette = color.Palette{Background, White, Red, Green, Yellow, Blue, Orange, Color(0xff40c0ff), Color(0x40c0c0ff), Grey}
+
+var paletteIndex = map[visual.Colour]uint8{
+	visual.Default: 1,
+	visual.Red:     2,
+	visual.Green:   3,
+	visual.Yellow:  4,
+	visual.Blue:    5,
+	visual.Magenta: 7,
+	visual.Cyan:    8,
+	visual.White:   1,
+	visual.Grey:    9,
+}
+
+// Color turns 0xRRGGBBAA into a colour
+func Color(rgba uint32) color.RGBA {
+	return color.RGBA{uint8(rgba >> 24), uint8(rgba >> 16), uint8(rgba >> 8), uint8(rgba)}
+}
+
+// FromFrame draws a terminal frame as an image, with each character as a scale x scale block. Spaces and
+// dots are left as background, so the shapes stand out.
+func FromFrame(f visual.Frame, scale int) *image.Paletted {
+	img := image.NewPaletted(image.Rect(0, 0, f.Cells.Width*scale, f.Cells.Height*scale), palette)
+
+	for p, cell := range f.Cells.All() {
+		index := paletteIndex[cell.Colour]
+
+		if cell.Char == ' ' || (cell.Char == '.' && cell.Colour != visual.Green) {
+			index = 0
+		}
+
+		for y := p.Y * scale; y < (p.Y+1)*scale; y++ {
+			for x := p.X * scale; x < (p.X+1)*scale; x++ {
+				img.SetColorIndex(x, y, index)
+			}
+		}
+	}
+
+	return img
+}
+
+// Animation records frames from a visual.Source into a GIF
+type Animation struct {
+	scale  int
+	delay  int
+	frames []*image.Paletted
+}
+
+// NewAnimation returns an animation drawing each character as a scale x scale block, playing at fps
+func NewAnimation(scale, fps int) *Animation {
+	return &Animation{scale: scale, delay: 100 / max(fps, 1)}
+}
+
+// Sink returns the sink to hand to a visual.Source
+func (a *Animation) Sink() visual.Sink {
+	return func(f visual.Frame) {
+		a.frames = append(a.frames, FromFrame(f, a.scale))
+	}
+}
+
+func (a *Animation) Len() int {
+	return len(a.frames)
+}
+
+// Save writes the animation to a GIF, holding on the last frame for a couple of seconds
+func (a *Animation) Save(path string) error {
+	if len(a.frames) == 0 {
+		return fmt.Errorf("%s: no frames recorded", path)
+	}
+
+	anim := &gif.GIF{}
+	for i, frame := range a.frames {
+		delay := a.delay
+		if i == len(a.frames)-1 {
+			delay = 200
+		}
+
+		anim.Image = append(anim.Image, frame)
+		anim.Delay = append(anim.Delay, delay)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := gif.EncodeAll(f, anim); err != nil {
+		f.Close()
+		os.Remove(path)
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,91 @@
+// Package export renders solver state to PNG snapshots and GIF animations, for write-ups. Solvers that
+// implement Source provide a snapshot of their state once they've been run, and the frames from a
+// visual.Source can be recorded into an Animation.
+package export
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+
+	"github.com/digdon/2025aoc/grid"
+)
+
+// Source is implemented by solvers that can draw their state. It's called after the parts have been run.
+type Source interface {
+	Snapshot() (image.Image, error)
+}
+
+var (
+	Background = color.RGBA{0x0f, 0x0f, 0x23, 0xff}
+	Red        = color.RGBA{0xe0, 0x40, 0x40, 0xff}
+	Green      = color.RGBA{0x40, 0xc0, 0x40, 0xff}
+	Blue       = color.RGBA{0x40, 0x80, 0xe0, 0xff}
+	Yellow     = color.RGBA{0xff, 0xff, 0x66, 0xff}
+	Orange     = color.RGBA{0xff, 0xa0, 0x40, 0xff}
+	Grey       = color.RGBA{0x60, 0x60, 0x70, 0xff}
+	White      = color.RGBA{0xcc, 0xcc, 0xcc, 0xff}
+)
+
+// SavePNG writes an image to a file
+func SavePNG(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	// A half written image is no use to anyone, so it's removed rather than left behind
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		os.Remove(path)
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return f.Close()
+}
+
+// Heatmap draws a grid of counts, with each cell as a scale x scale block. The counts can span many orders
+// of magnitude (ie, day 7's timelines), so the colour follows the number of digits rather than the value.
+func Heatmap(values *grid.Dense[int], scale int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, values.Width*scale, values.Height*scale))
+
+	maxDigits := 1
+	for _, v := range values.All() {
+		maxDigits = max(maxDigits, len(fmt.Sprint(v)))
+	}
+
+	for p, v := range values.All() {
+		c := Background
+
+		if v > 0 {
+			c = heat(float64(len(fmt.Sprint(v))) / float64(maxDigits))
+		}
+
+		fill(img, image.Rect(p.X*scale, p.Y*scale, (p.X+1)*scale, (p.Y+1)*scale), c)
+	}
+
+	return img
+}
+
+// Blue through yellow to white, for t from 0 to 1
+func heat(t float64) color.RGBA {
+	lerp := func(a, b uint8, t float64) uint8 { return uint8(float64(a) + (float64(b)-float64(a))*t) }
+
+	if t < 0.5 {
+		t *= 2
+		return color.RGBA{lerp(Blue.R, Yellow.R, t), lerp(Blue.G, Yellow.G, t), lerp(Blue.B, Yellow.B, t), 0xff}
+	}
+
+	t = (t - 0.5) * 2
+	return color.RGBA{lerp(Yellow.R, 0xff, t), lerp(Yellow.G, 0xff, t), lerp(Yellow.B, 0xff, t), 0xff}
+}
+
+func fill(img *image.RGBA, r image.Rectangle, c color.Color) {
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			img.Set(x, y, c)
+		}
+	}
+}
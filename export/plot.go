@@ -0,0 +1,100 @@
+package export
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/digdon/2025aoc/grid"
+)
+
+// Plot draws points, lines and rectangles given in puzzle coordinates, which can be far bigger than the image
+// (ie, day 9's tiles run to about 100,000). Everything is scaled to fit, keeping the aspect ratio.
+type Plot struct {
+	img    *image.RGBA
+	min    grid.Point
+	scale  float64
+	margin int
+}
+
+// NewPlot returns a plot at most size pixels across, with bounds covering all of the given points
+func NewPlot(size int, points []grid.Point) *Plot {
+	const margin = 10
+
+	lo, hi := points[0], points[0]
+	for _, p := range points {
+		lo = grid.Point{X: min(lo.X, p.X), Y: min(lo.Y, p.Y)}
+		hi = grid.Point{X: max(hi.X, p.X), Y: max(hi.Y, p.Y)}
+	}
+
+	span := max(hi.X-lo.X, hi.Y-lo.Y, 1)
+	scale := float64(size-2*margin) / float64(span)
+	width := int(float64(hi.X-lo.X)*scale) + 2*margin + 1
+	height := int(float64(hi.Y-lo.Y)*scale) + 2*margin + 1
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	fill(img, img.Bounds(), Background)
+
+	return &Plot{img: img, min: lo, scale: scale, margin: margin}
+}
+
+func (p *Plot) Image() image.Image {
+	return p.img
+}
+
+// Converts puzzle coordinates to pixels
+func (p *Plot) pixel(pt grid.Point) image.Point {
+	return image.Point{
+		X: p.margin + int(float64(pt.X-p.min.X)*p.scale),
+		Y: p.margin + int(float64(pt.Y-p.min.Y)*p.scale),
+	}
+}
+
+// Point draws a dot with the given radius in pixels
+func (p *Plot) Point(pt grid.Point, c color.Color, radius int) {
+	centre := p.pixel(pt)
+	fill(p.img, image.Rect(centre.X-radius, centre.Y-radius, centre.X+radius+1, centre.Y+radius+1), c)
+}
+
+// Line draws a straight line between two points
+func (p *Plot) Line(a, b grid.Point, c color.Color) {
+	from, to := p.pixel(a), p.pixel(b)
+	steps := max(abs(to.X-from.X), abs(to.Y-from.Y), 1)
+
+	for i := 0; i <= steps; i++ {
+		x := from.X + (to.X-from.X)*i/steps
+		y := from.Y + (to.Y-from.Y)*i/steps
+		p.img.Set(x, y, c)
+	}
+}
+
+// Polygon draws the outline through the points, closing it back to the start
+func (p *Plot) Polygon(points []grid.Point, c color.Color) {
+	for i := range points {
+		p.Line(points[i], points[(i+1)%len(points)], c)
+	}
+}
+
+// FillRect shades the rectangle between two opposite corners, blending with what's already drawn
+func (p *Plot) FillRect(a, b grid.Point, c color.RGBA) {
+	from := p.pixel(grid.Point{X: min(a.X, b.X), Y: min(a.Y, b.Y)})
+	to := p.pixel(grid.Point{X: max(a.X, b.X), Y: max(a.Y, b.Y)})
+
+	for y := from.Y; y <= to.Y; y++ {
+		for x := from.X; x <= to.X; x++ {
+			under := p.img.RGBAAt(x, y)
+			p.img.SetRGBA(x, y, color.RGBA{blend(under.R, c.R), blend(under.G, c.G), blend(under.B, c.B), 0xff})
+		}
+	}
+}
+
+func blend(a, b uint8) uint8 {
+	return uint8((int(a) + int(b)) / 2)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -0,0 +1,68 @@
+// Package generate synthesises random puzzle inputs, for stress-testing the solvers well beyond the size
+// of the real inputs and for comparing alternate implementations against each other.
+package generate
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+)
+
+// Generator builds an input for one day. What size counts depends on the day (see Size).
+type Generator struct {
+	// What the size controls, ie "rotations" or "junction boxes"
+	Size string
+
+	// Roughly the size of the real puzzle input
+	Default int
+
+	generate func(rng *rand.Rand, size int) []string
+}
+
+// Generators by year, then by day
+var generators = map[int]map[int]Generator{}
+
+func register(year, day int, g Generator) {
+	if generators[year] == nil {
+		generators[year] = map[int]Generator{}
+	}
+
+	generators[year][day] = g
+}
+
+// Lookup returns the generator for a day
+func Lookup(year, day int) (Generator, error) {
+	g, found := generators[year][day]
+	if !found {
+		return Generator{}, fmt.Errorf("no input generator for %d day %d", year, day)
+	}
+
+	return g, nil
+}
+
+// Days returns all of the days in a year with a generator, in order
+func Days(year int) []int {
+	days := []int{}
+
+	for day := range generators[year] {
+		days = append(days, day)
+	}
+
+	sort.Ints(days)
+
+	return days
+}
+
+// Generate builds an input for a day. The same day, size and seed always produce the same input.
+func Generate(year, day, size int, seed uint64) ([]string, error) {
+	g, err := Lookup(year, day)
+	if err != nil {
+		return nil, err
+	}
+
+	if size < 1 {
+		return nil, fmt.Errorf("size must be at least 1, got %d", size)
+	}
+
+	return g.generate(rand.New(rand.NewPCG(seed, uint64(day))), size), nil
+}
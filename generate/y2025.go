@@ -0,0 +1,446 @@
+package generate
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	register(2025, 1, Generator{Size: "rotations", Default: 4000, generate: day01})
+	register(2025, 2, Generator{Size: "ID ranges", Default: 35, generate: day02})
+	register(2025, 3, Generator{Size: "battery banks", Default: 200, generate: day03})
+	register(2025, 4, Generator{Size: "grid width and height", Default: 140, generate: day04})
+	register(2025, 5, Generator{Size: "fresh ID ranges", Default: 180, generate: day05})
+	register(2025, 6, Generator{Size: "problems", Default: 1000, generate: day06})
+	register(2025, 7, Generator{Size: "manifold rows", Default: 142, generate: day07})
+	register(2025, 8, Generator{Size: "junction boxes", Default: 1000, generate: day08})
+	register(2025, 9, Generator{Size: "polygon columns", Default: 120, generate: day09})
+	register(2025, 10, Generator{Size: "buttons per machine", Default: 10, generate: day10})
+	register(2025, 11, Generator{Size: "DAG layers", Default: 30, generate: day11})
+	register(2025, 12, Generator{Size: "regions", Default: 1000, generate: day12})
+}
+
+// Random integer in [lo, hi]
+func between(rng *rand.Rand, lo, hi int) int {
+	return lo + rng.IntN(hi-lo+1)
+}
+
+func day01(rng *rand.Rand, size int) []string {
+	lines := make([]string, size)
+
+	for i := range lines {
+		lines[i] = fmt.Sprintf("%c%d", "LR"[rng.IntN(2)], between(rng, 1, 999))
+	}
+
+	return lines
+}
+
+// Part 1 and 2 walk every ID in every range, so the ranges are kept to the sort of width the real input uses
+func day02(rng *rand.Rand, size int) []string {
+	ranges := make([]string, size)
+
+	for i := range ranges {
+		digits := between(rng, 1, 10)
+		start := between(rng, 1, int(pow10(digits))-1)
+		end := start + rng.IntN(100000)
+		ranges[i] = fmt.Sprintf("%d-%d", start, end)
+	}
+
+	return []string{strings.Join(ranges, ",")}
+}
+
+func pow10(n int) int64 {
+	v := int64(1)
+	for range n {
+		v *= 10
+	}
+
+	return v
+}
+
+func day03(rng *rand.Rand, size int) []string {
+	lines := make([]string, size)
+
+	for i := range lines {
+		bank := make([]byte, 100)
+		for j := range bank {
+			bank[j] = byte('1' + rng.IntN(9))
+		}
+		lines[i] = string(bank)
+	}
+
+	return lines
+}
+
+func day04(rng *rand.Rand, size int) []string {
+	lines := make([]string, size)
+
+	for y := range lines {
+		row := make([]byte, size)
+		for x := range row {
+			row[x] = '.'
+			if rng.Float64() < 0.6 {
+				row[x] = '@'
+			}
+		}
+		lines[y] = string(row)
+	}
+
+	return lines
+}
+
+// Overlapping ranges of large IDs, then a list of IDs of which about half fall in a range
+func day05(rng *rand.Rand, size int) []string {
+	lines := []string{}
+	ranges := make([][2]int, size)
+
+	for i := range ranges {
+		start := between(rng, 1, 500_000_000_000_000)
+		ranges[i] = [2]int{start, start + rng.IntN(1_000_000_000_000)}
+		lines = append(lines, fmt.Sprintf("%d-%d", ranges[i][0], ranges[i][1]))
+	}
+
+	lines = append(lines, "")
+
+	for range size * 5 {
+		id := between(rng, 1, 500_000_000_000_000)
+		if rng.IntN(2) == 0 {
+			r := ranges[rng.IntN(len(ranges))]
+			id = between(rng, r[0], r[1])
+		}
+		lines = append(lines, strconv.Itoa(id))
+	}
+
+	return lines
+}
+
+// Problems are four numbers in a column, each column as wide as its longest number. The numbers in a
+// column are either all left or all right aligned, which matters for part 2's reading of the digits.
+func day06(rng *rand.Rand, size int) []string {
+	const rows = 4
+	lines := make([]strings.Builder, rows+1)
+
+	for p := range size {
+		numbers := make([]string, rows)
+		width := 0
+
+		for i := range numbers {
+			digits := make([]byte, between(rng, 1, 4))
+			for j := range digits {
+				digits[j] = byte('1' + rng.IntN(9))
+			}
+			numbers[i] = string(digits)
+			width = max(width, len(digits))
+		}
+
+		rightAlign := rng.IntN(2) == 0
+
+		for i, number := range numbers {
+			if p > 0 {
+				lines[i].WriteByte(' ')
+			}
+
+			padding := strings.Repeat(" ", width-len(number))
+			if rightAlign {
+				lines[i].WriteString(padding + number)
+			} else {
+				lines[i].WriteString(number + padding)
+			}
+		}
+
+		if p > 0 {
+			lines[rows].WriteByte(' ')
+		}
+		lines[rows].WriteString(string("*+"[rng.IntN(2)]) + strings.Repeat(" ", width-1))
+	}
+
+	result := make([]string, len(lines))
+	for i := range lines {
+		result[i] = lines[i].String()
+	}
+
+	return result
+}
+
+// The same shape as the real input: splitters on every other row, in a widening triangle below the start,
+// with some of them missing
+func day07(rng *rand.Rand, size int) []string {
+	height := max(size, 2)
+	width := height + 3
+	centre := width / 2
+	lines := make([]string, height)
+
+	for y := range lines {
+		row := []byte(strings.Repeat(".", width))
+
+		if y == 0 {
+			row[centre] = 'S'
+		} else if y%2 == 0 {
+			k := y / 2
+			for x := centre - (k - 1); x <= centre+(k-1); x += 2 {
+				if x > 0 && x < width-1 && (k == 1 || rng.Float64() < 0.8) {
+					row[x] = '^'
+				}
+			}
+		}
+
+		lines[y] = string(row)
+	}
+
+	return lines
+}
+
+func day08(rng *rand.Rand, size int) []string {
+	lines := make([]string, size)
+
+	for i := range lines {
+		lines[i] = fmt.Sprintf("%d,%d,%d", rng.IntN(100000), rng.IntN(100000), rng.IntN(100000))
+	}
+
+	return lines
+}
+
+// A rectilinear polygon made of side-by-side columns, each with its own top and bottom. The tops all sit
+// above the middle and the bottoms below it, so neighbouring columns always overlap and the outline never
+// crosses itself. Each column adds up to four red tiles.
+func day09(rng *rand.Rand, size int) []string {
+	const height = 100000
+	xs := make([]int, size+1)
+	tops, bottoms := make([]int, size), make([]int, size)
+
+	xs[0] = rng.IntN(1000)
+	for i := range size {
+		xs[i+1] = xs[i] + between(rng, 1, 2000)
+
+		tops[i] = between(rng, height/2+1, height)
+		bottoms[i] = between(rng, 0, height/2-1)
+
+		// Neighbouring columns need different tops and bottoms, otherwise they'd just be one wider column
+		for i > 0 && tops[i] == tops[i-1] {
+			tops[i] = between(rng, height/2+1, height)
+		}
+		for i > 0 && bottoms[i] == bottoms[i-1] {
+			bottoms[i] = between(rng, 0, height/2-1)
+		}
+	}
+
+	points := [][2]int{}
+
+	// Left to right along the tops, then back along the bottoms
+	for i := range size {
+		points = append(points, [2]int{xs[i], tops[i]}, [2]int{xs[i+1], tops[i]})
+	}
+
+	for i := size - 1; i >= 0; i-- {
+		points = append(points, [2]int{xs[i+1], bottoms[i]}, [2]int{xs[i], bottoms[i]})
+	}
+
+	lines := make([]string, len(points))
+	for i, p := range points {
+		lines[i] = fmt.Sprintf("%d,%d", p[0], p[1])
+	}
+
+	return lines
+}
+
+// The target lights and joltages are worked out from random button presses, so every machine has a solution
+func day10(rng *rand.Rand, size int) []string {
+	lines := make([]string, 150)
+
+	for m := range lines {
+		lightCount := between(rng, 4, 10)
+		counts := make([]int, lightCount)
+		parts := []string{}
+
+		for range size {
+			button := []string{}
+			presses := rng.IntN(20)
+
+			for light := range lightCount {
+				if rng.IntN(3) == 0 {
+					button = append(button, strconv.Itoa(light))
+					counts[light] += presses
+				}
+			}
+
+			if len(button) == 0 {
+				light := rng.IntN(lightCount)
+				button = append(button, strconv.Itoa(light))
+				counts[light] += presses
+			}
+
+			parts = append(parts, "("+strings.Join(button, ",")+")")
+		}
+
+		lights := make([]byte, lightCount)
+		joltages := make([]string, lightCount)
+
+		for i, count := range counts {
+			lights[i] = ".#"[count%2]
+			joltages[i] = strconv.Itoa(count)
+		}
+
+		lines[m] = "[" + string(lights) + "] " + strings.Join(parts, " ") + " {" + strings.Join(joltages, ",") + "}"
+	}
+
+	return lines
+}
+
+// A layered DAG, running from svr at the top to out at the bottom, with fft and dac part way down and a
+// path guaranteed between them. Every device links to one or two devices in the next layer, so the number
+// of paths grows quickly with depth: beyond about 150 layers the part 2 count overflows an int. Part 1
+// walks every path from you without memoizing, so you sits a fixed distance from the bottom.
+func day11(rng *rand.Rand, size int) []string {
+	// Enough layers for svr, fft, dac and you to each have their own
+	size = max(size, 4)
+
+	names := deviceNames()
+	layers := [][]string{{"svr"}}
+
+	for range size {
+		layer := make([]string, between(rng, 5, 15))
+		for i := range layer {
+			layer[i] = names()
+		}
+		layers = append(layers, layer)
+	}
+
+	fftLayer := size / 3
+	dacLayer := 2 * size / 3
+	youLayer := max(dacLayer+1, size-12)
+
+	layers[fftLayer][rng.IntN(len(layers[fftLayer]))] = "fft"
+	layers[dacLayer][rng.IntN(len(layers[dacLayer]))] = "dac"
+	layers[youLayer][rng.IntN(len(layers[youLayer]))] = "you"
+
+	links := map[string][]string{}
+
+	for l := 0; l < len(layers)-1; l++ {
+		next := layers[l+1]
+
+		// Every device in the next layer needs a way in
+		for _, device := range next {
+			from := layers[l][rng.IntN(len(layers[l]))]
+			links[from] = appendUnique(links[from], device)
+		}
+
+		for _, device := range layers[l] {
+			for len(links[device]) == 0 || (len(links[device]) < 2 && rng.IntN(3) == 0) {
+				links[device] = appendUnique(links[device], next[rng.IntN(len(next))])
+			}
+		}
+	}
+
+	// A chain of links from fft down to dac
+	chain := "fft"
+	for l := fftLayer + 1; l <= dacLayer; l++ {
+		device := layers[l][rng.IntN(len(layers[l]))]
+		if l == dacLayer {
+			device = "dac"
+		}
+
+		links[chain] = appendUnique(links[chain], device)
+		chain = device
+	}
+
+	for _, device := range layers[len(layers)-1] {
+		links[device] = []string{"out"}
+	}
+
+	lines := []string{}
+	for _, layer := range layers {
+		for _, device := range layer {
+			lines = append(lines, device+": "+strings.Join(links[device], " "))
+		}
+	}
+
+	rng.Shuffle(len(lines), func(i, j int) { lines[i], lines[j] = lines[j], lines[i] })
+
+	return lines
+}
+
+// Returns a function handing out three letter device names, skipping the ones with special meanings
+func deviceNames() func() string {
+	reserved := map[string]bool{"svr": true, "you": true, "out": true, "fft": true, "dac": true}
+	next := 0
+
+	return func() string {
+		for {
+			name := ""
+			for n := next; len(name) < 3 || n > 0; n /= 26 {
+				name = string(rune('a'+n%26)) + name
+			}
+			next++
+
+			if !reserved[name] {
+				return name
+			}
+		}
+	}
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+
+	return append(list, value)
+}
+
+// Six 3x3 presents, then regions that are either big enough to hold every present side by side or too small
+// to hold their total area, which is what the real input turned out to look like
+func day12(rng *rand.Rand, size int) []string {
+	lines := []string{}
+	areas := make([]int, 6)
+
+	for p := range 6 {
+		lines = append(lines, fmt.Sprintf("%d:", p))
+		shape := []byte("#########")
+
+		// Knock out a few cells, keeping the centre
+		for range between(rng, 2, 3) {
+			if cell := rng.IntN(9); cell != 4 {
+				shape[cell] = '.'
+			}
+		}
+
+		for row := range 3 {
+			lines = append(lines, string(shape[row*3:row*3+3]))
+		}
+
+		areas[p] = strings.Count(string(shape), "#")
+		lines = append(lines, "")
+	}
+
+	for range size {
+		width, length := between(rng, 35, 50), between(rng, 35, 50)
+		counts := make([]int, 6)
+
+		if rng.IntN(2) == 0 {
+			// Fits side by side
+			for range (width/3)*(length/3) - rng.IntN(5) {
+				counts[rng.IntN(6)]++
+			}
+		} else {
+			// Too much area to fit at all
+			for area := 0; area <= width*length; {
+				p := rng.IntN(6)
+				counts[p]++
+				area += areas[p]
+			}
+		}
+
+		values := make([]string, 6)
+		for i, count := range counts {
+			values[i] = strconv.Itoa(count)
+		}
+
+		lines = append(lines, fmt.Sprintf("%dx%d: %s", width, length, strings.Join(values, " ")))
+	}
+
+	return lines
+}
@@ -0,0 +1,3 @@
+module github.com/digdon/2025aoc
+
+go 1.25
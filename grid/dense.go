@@ -0,0 +1,148 @@
+package grid
+
+import (
+	"iter"
+
+	"github.com/digdon/2025aoc/input"
+)
+
+// Dense is a fixed-size rectangular grid. Reads outside the grid return the zero value, and writes
+// outside it are ignored, so callers don't need to do their own bounds checks.
+type Dense[T any] struct {
+	Width  int
+	Height int
+	cells  []T
+}
+
+func NewDense[T any](width, height int) *Dense[T] {
+	return &Dense[T]{Width: width, Height: height, cells: make([]T, width*height)}
+}
+
+// Parse builds a character grid from the input lines, which must all be the same width
+func Parse(lines []string) (*Dense[byte], error) {
+	rows, err := input.Grid(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	width := 0
+	if len(rows) > 0 {
+		width = len(rows[0])
+	}
+
+	g := NewDense[byte](width, len(rows))
+
+	for y, row := range rows {
+		copy(g.cells[y*width:], row)
+	}
+
+	return g, nil
+}
+
+func (g *Dense[T]) InBounds(p Point) bool {
+	return p.X >= 0 && p.X < g.Width && p.Y >= 0 && p.Y < g.Height
+}
+
+// Get returns the value at p, or the zero value if p is outside the grid
+func (g *Dense[T]) Get(p Point) T {
+	v, _ := g.Lookup(p)
+	return v
+}
+
+// Lookup returns the value at p, and whether p is inside the grid
+func (g *Dense[T]) Lookup(p Point) (T, bool) {
+	if !g.InBounds(p) {
+		var zero T
+		return zero, false
+	}
+
+	return g.cells[p.Y*g.Width+p.X], true
+}
+
+// Set stores a value at p, returning false (and doing nothing) if p is outside the grid
+func (g *Dense[T]) Set(p Point, v T) bool {
+	if !g.InBounds(p) {
+		return false
+	}
+
+	g.cells[p.Y*g.Width+p.X] = v
+
+	return true
+}
+
+// All iterates over every cell, row by row
+func (g *Dense[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, v := range g.cells {
+			if !yield(Point{X: i % g.Width, Y: i / g.Width}, v) {
+				return
+			}
+		}
+	}
+}
+
+// Find returns the first cell (row by row) matching the predicate
+func (g *Dense[T]) Find(match func(T) bool) (Point, bool) {
+	for p, v := range g.All() {
+		if match(v) {
+			return p, true
+		}
+	}
+
+	return Point{}, false
+}
+
+// Neighbours4 iterates over the orthogonal neighbours of p that are inside the grid
+func (g *Dense[T]) Neighbours4(p Point) iter.Seq2[Point, T] {
+	return g.neighbours(p, Directions4)
+}
+
+// Neighbours8 iterates over the orthogonal and diagonal neighbours of p that are inside the grid
+func (g *Dense[T]) Neighbours8(p Point) iter.Seq2[Point, T] {
+	return g.neighbours(p, Directions8)
+}
+
+func (g *Dense[T]) neighbours(p Point, directions []Point) iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for _, d := range directions {
+			n := p.Add(d)
+
+			if v, ok := g.Lookup(n); ok && !yield(n, v) {
+				return
+			}
+		}
+	}
+}
+
+// Rotate returns a copy of the grid rotated 90 degrees clockwise
+func (g *Dense[T]) Rotate() *Dense[T] {
+	r := NewDense[T](g.Height, g.Width)
+
+	for p, v := range g.All() {
+		r.Set(Point{X: g.Height - 1 - p.Y, Y: p.X}, v)
+	}
+
+	return r
+}
+
+// FlipHorizontal returns a copy of the grid mirrored left to right
+func (g *Dense[T]) FlipHorizontal() *Dense[T] {
+	f := NewDense[T](g.Width, g.Height)
+
+	for p, v := range g.All() {
+		f.Set(Point{X: g.Width - 1 - p.X, Y: p.Y}, v)
+	}
+
+	return f
+}
+
+// FlipVertical returns a copy of the grid mirrored top to bottom
+func (g *Dense[T]) FlipVertical() *Dense[T] {
+	f := NewDense[T](g.Width, g.Height)
+
+	for p, v := range g.All() {
+		f.Set(Point{X: p.X, Y: g.Height - 1 - p.Y}, v)
+	}
+
+	return f
+}
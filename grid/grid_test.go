@@ -0,0 +1,179 @@
+package grid
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+// Turns a character grid back into lines, for comparing against the expected layout
+func rows(g *Dense[byte]) []string {
+	lines := make([]string, g.Height)
+
+	for y := range g.Height {
+		row := make([]byte, g.Width)
+		for x := range g.Width {
+			row[x] = g.Get(Point{X: x, Y: y})
+		}
+
+		lines[y] = string(row)
+	}
+
+	return lines
+}
+
+func TestTransforms(t *testing.T) {
+	g, err := Parse([]string{"abc", "def"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		got  *Dense[byte]
+		want []string
+	}{
+		{"rotate", g.Rotate(), []string{"da", "eb", "fc"}},
+		{"rotate twice", g.Rotate().Rotate(), []string{"fed", "cba"}},
+		{"rotate four times", g.Rotate().Rotate().Rotate().Rotate(), []string{"abc", "def"}},
+		{"flip horizontal", g.FlipHorizontal(), []string{"cba", "fed"}},
+		{"flip vertical", g.FlipVertical(), []string{"def", "abc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rows(tt.got); !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// None of them should touch the original
+	if got := rows(g); !slices.Equal(got, []string{"abc", "def"}) {
+		t.Errorf("original is now %q", got)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	g := NewDense[int](3, 2)
+
+	tests := []struct {
+		p  Point
+		in bool
+	}{
+		{Point{X: 0, Y: 0}, true},
+		{Point{X: 2, Y: 1}, true},
+		{Point{X: -1, Y: 0}, false},
+		{Point{X: 0, Y: -1}, false},
+		{Point{X: 3, Y: 0}, false},
+		{Point{X: 0, Y: 2}, false},
+		{Point{X: 3, Y: -1}, false},
+	}
+
+	for _, tt := range tests {
+		if set := g.Set(tt.p, 7); set != tt.in {
+			t.Errorf("Set(%v) gave %v, want %v", tt.p, set, tt.in)
+		}
+
+		v, found := g.Lookup(tt.p)
+		if found != tt.in || (tt.in && v != 7) || (!tt.in && v != 0) {
+			t.Errorf("Lookup(%v) gave %d, %v", tt.p, v, found)
+		}
+
+		if got := g.Get(tt.p); got != v {
+			t.Errorf("Get(%v) gave %d, but Lookup gave %d", tt.p, got, v)
+		}
+	}
+
+	// Writes off the edge mustn't wrap around onto another row
+	cells, sevens := 0, 0
+
+	for _, v := range g.All() {
+		cells++
+
+		if v == 7 {
+			sevens++
+		}
+	}
+
+	if cells != 6 || sevens != 2 {
+		t.Errorf("%d of %d cells were set, want 2 of 6", sevens, cells)
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	g := NewDense[int](3, 3)
+	s := NewSparse[int]()
+
+	for p := range g.All() {
+		s.Set(p, 0)
+	}
+
+	tests := []struct {
+		name string
+		p    Point
+		n4   int
+		n8   int
+	}{
+		{"corner", Point{X: 0, Y: 0}, 2, 3},
+		{"other corner", Point{X: 2, Y: 2}, 2, 3},
+		{"edge", Point{X: 1, Y: 0}, 3, 5},
+		{"middle", Point{X: 1, Y: 1}, 4, 8},
+		{"just outside", Point{X: -1, Y: 1}, 1, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counts := []struct {
+				what string
+				got  int
+				want int
+			}{
+				{"dense Neighbours4", len(maps.Collect(g.Neighbours4(tt.p))), tt.n4},
+				{"dense Neighbours8", len(maps.Collect(g.Neighbours8(tt.p))), tt.n8},
+				{"sparse Neighbours4", len(maps.Collect(s.Neighbours4(tt.p))), tt.n4},
+				{"sparse Neighbours8", len(maps.Collect(s.Neighbours8(tt.p))), tt.n8},
+			}
+
+			for _, c := range counts {
+				if c.got != c.want {
+					t.Errorf("%s gave %d, want %d", c.what, c.got, c.want)
+				}
+			}
+
+			for n := range g.Neighbours8(tt.p) {
+				if !g.InBounds(n) || n.Chebyshev(tt.p) != 1 {
+					t.Errorf("%v isn't a neighbour of %v inside the grid", n, tt.p)
+				}
+			}
+		})
+	}
+}
+
+func TestSparseBounds(t *testing.T) {
+	tests := []struct {
+		name        string
+		points      []Point
+		topLeft     Point
+		bottomRight Point
+	}{
+		{"empty", nil, Point{}, Point{}},
+		{"one point", []Point{{X: 5, Y: -3}}, Point{X: 5, Y: -3}, Point{X: 5, Y: -3}},
+		{"spread out", []Point{{X: 2, Y: 7}, {X: -4, Y: 1}, {X: 0, Y: 9}}, Point{X: -4, Y: 1}, Point{X: 2, Y: 9}},
+		{"all negative", []Point{{X: -2, Y: -7}, {X: -9, Y: -1}}, Point{X: -9, Y: -7}, Point{X: -2, Y: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSparse[bool]()
+			for _, p := range tt.points {
+				s.Set(p, true)
+			}
+
+			topLeft, bottomRight := s.Bounds()
+			if topLeft != tt.topLeft || bottomRight != tt.bottomRight {
+				t.Errorf("got %v to %v, want %v to %v", topLeft, bottomRight, tt.topLeft, tt.bottomRight)
+			}
+		})
+	}
+}
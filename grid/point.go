@@ -0,0 +1,54 @@
+// Package grid holds the 2D grid pieces shared by the grid-based days: points, neighbour directions, and
+// dense and sparse grids.
+package grid
+
+type Point struct {
+	X int
+	Y int
+}
+
+func (p Point) Add(q Point) Point {
+	return Point{X: p.X + q.X, Y: p.Y + q.Y}
+}
+
+func (p Point) Sub(q Point) Point {
+	return Point{X: p.X - q.X, Y: p.Y - q.Y}
+}
+
+// Manhattan returns the taxicab distance between two points
+func (p Point) Manhattan(q Point) int {
+	return abs(p.X-q.X) + abs(p.Y-q.Y)
+}
+
+// Chebyshev returns the king-move distance between two points
+func (p Point) Chebyshev(q Point) int {
+	return max(abs(p.X-q.X), abs(p.Y-q.Y))
+}
+
+// Orthogonal neighbour offsets, clockwise from up
+var Directions4 = []Point{
+	{X: 0, Y: -1},
+	{X: 1, Y: 0},
+	{X: 0, Y: 1},
+	{X: -1, Y: 0},
+}
+
+// Orthogonal and diagonal neighbour offsets, clockwise from up-left
+var Directions8 = []Point{
+	{X: -1, Y: -1},
+	{X: 0, Y: -1},
+	{X: 1, Y: -1},
+	{X: 1, Y: 0},
+	{X: 1, Y: 1},
+	{X: 0, Y: 1},
+	{X: -1, Y: 1},
+	{X: -1, Y: 0},
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -0,0 +1,107 @@
+package grid
+
+import (
+	"iter"
+	"maps"
+)
+
+// Sparse is an unbounded grid that only stores the cells that have been set
+type Sparse[T any] struct {
+	cells map[Point]T
+}
+
+func NewSparse[T any]() *Sparse[T] {
+	return &Sparse[T]{cells: map[Point]T{}}
+}
+
+// ParseSparse builds a sparse grid holding only the characters that keep accepts
+func ParseSparse(lines []string, keep func(byte) bool) *Sparse[byte] {
+	g := NewSparse[byte]()
+
+	for y, line := range lines {
+		for x := range len(line) {
+			if keep(line[x]) {
+				g.Set(Point{X: x, Y: y}, line[x])
+			}
+		}
+	}
+
+	return g
+}
+
+func (g *Sparse[T]) Len() int {
+	return len(g.cells)
+}
+
+func (g *Sparse[T]) Has(p Point) bool {
+	_, found := g.cells[p]
+	return found
+}
+
+// Get returns the value at p, or the zero value if nothing is stored there
+func (g *Sparse[T]) Get(p Point) T {
+	return g.cells[p]
+}
+
+func (g *Sparse[T]) Lookup(p Point) (T, bool) {
+	v, found := g.cells[p]
+	return v, found
+}
+
+func (g *Sparse[T]) Set(p Point, v T) {
+	g.cells[p] = v
+}
+
+func (g *Sparse[T]) Delete(p Point) {
+	delete(g.cells, p)
+}
+
+func (g *Sparse[T]) Clone() *Sparse[T] {
+	return &Sparse[T]{cells: maps.Clone(g.cells)}
+}
+
+// All iterates over the stored cells, in no particular order
+func (g *Sparse[T]) All() iter.Seq2[Point, T] {
+	return maps.All(g.cells)
+}
+
+// Bounds returns the top-left and bottom-right corners of the stored cells
+func (g *Sparse[T]) Bounds() (Point, Point) {
+	var topLeft, bottomRight Point
+	first := true
+
+	for p := range g.cells {
+		if first {
+			topLeft, bottomRight = p, p
+			first = false
+			continue
+		}
+
+		topLeft = Point{X: min(topLeft.X, p.X), Y: min(topLeft.Y, p.Y)}
+		bottomRight = Point{X: max(bottomRight.X, p.X), Y: max(bottomRight.Y, p.Y)}
+	}
+
+	return topLeft, bottomRight
+}
+
+// Neighbours4 iterates over the stored orthogonal neighbours of p
+func (g *Sparse[T]) Neighbours4(p Point) iter.Seq2[Point, T] {
+	return g.neighbours(p, Directions4)
+}
+
+// Neighbours8 iterates over the stored orthogonal and diagonal neighbours of p
+func (g *Sparse[T]) Neighbours8(p Point) iter.Seq2[Point, T] {
+	return g.neighbours(p, Directions8)
+}
+
+func (g *Sparse[T]) neighbours(p Point, directions []Point) iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for _, d := range directions {
+			n := p.Add(d)
+
+			if v, found := g.cells[n]; found && !yield(n, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package input
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Error describes a problem with the input. Line and Col are 1-based, with 0 meaning unknown. Text is the
+// offending line and Expected describes the format that was expected there.
+type Error struct {
+	Line     int
+	Col      int
+	Text     string
+	Expected string
+	Err      error
+}
+
+func (e *Error) Error() string {
+	var sb strings.Builder
+
+	if e.Line > 0 {
+		sb.WriteString(fmt.Sprintf("line %d", e.Line))
+	}
+
+	if e.Col > 0 {
+		if sb.Len() > 0 {
+			sb.WriteString(", ")
+		}
+
+		sb.WriteString(fmt.Sprintf("col %d", e.Col))
+	}
+
+	if sb.Len() > 0 {
+		sb.WriteString(": ")
+	}
+
+	sb.WriteString(e.Err.Error())
+
+	if e.Text != "" {
+		sb.WriteString(fmt.Sprintf(" in %q", e.Text))
+	}
+
+	if e.Expected != "" {
+		sb.WriteString(fmt.Sprintf(" (expected %s)", e.Expected))
+	}
+
+	return sb.String()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// AtLine attaches a line number (and the line's text) to an error. Column information from an existing
+// Error is kept.
+func AtLine(err error, line int, text string) error {
+	if err == nil {
+		return nil
+	}
+
+	return atLine(err, line, text, "")
+}
+
+func atLine(err error, line int, text string, expected string) *Error {
+	if ie, ok := err.(*Error); ok {
+		if expected == "" {
+			expected = ie.Expected
+		}
+
+		return &Error{Line: line, Col: ie.Col, Text: text, Expected: expected, Err: ie.Err}
+	}
+
+	return &Error{Line: line, Text: text, Expected: expected, Err: err}
+}
+
+// Errors collects every bad line found by a parser. Parsers keep going after a bad line, so that all of
+// the problems get reported at once, and so that a caller can choose to skip the bad lines and carry on
+// with whatever did parse.
+type Errors []*Error
+
+// Add records a problem with a line. The line number is 1-based.
+func (e *Errors) Add(line int, text string, expected string, err error) {
+	*e = append(*e, atLine(err, line, text, expected))
+}
+
+// Err returns the collected errors, or nil if there weren't any
+func (e Errors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+func (e Errors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+
+	messages := []string{fmt.Sprintf("%d bad lines:", len(e))}
+
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "\n  ")
+}
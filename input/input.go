@@ -0,0 +1,177 @@
+// Package input reads puzzle input and splits it into the shapes the days need: lines, blank-line separated
+// sections, character grids, integer lists and ranges.
+package input
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/digdon/2025aoc/interval"
+)
+
+// Load reads all of the lines from a file, or from stdin if the path is -
+func Load(path string) ([]string, error) {
+	if path == "-" {
+		return Read(os.Stdin)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Read(f)
+}
+
+// Read reads all of the lines from r
+func Read(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// Split breaks text (ie, an embedded example) into lines, dropping the final newline
+func Split(text string) []string {
+	text = strings.TrimSuffix(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(text, "\n")
+}
+
+// Section is a block of lines separated from its neighbours by blank lines. Line is the 1-based
+// line number of the first line in the block.
+type Section struct {
+	Line  int
+	Lines []string
+}
+
+// Sections splits lines into blocks separated by one or more blank lines
+func Sections(lines []string) []Section {
+	sections := []Section{}
+	var current *Section
+
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			current = nil
+			continue
+		}
+
+		if current == nil {
+			sections = append(sections, Section{Line: i + 1})
+			current = &sections[len(sections)-1]
+		}
+
+		current.Lines = append(current.Lines, line)
+	}
+
+	return sections
+}
+
+// Grid converts lines into a rectangular grid of characters, indexed [y][x]
+func Grid(lines []string) ([][]byte, error) {
+	grid := make([][]byte, len(lines))
+
+	for y, line := range lines {
+		if y > 0 && len(line) != len(lines[0]) {
+			return nil, &Error{Line: y + 1, Col: min(len(line), len(lines[0])) + 1, Text: line,
+				Err: fmt.Errorf("row is %d wide, expected %d", len(line), len(lines[0]))}
+		}
+
+		grid[y] = []byte(line)
+	}
+
+	return grid, nil
+}
+
+// Ints parses a list of integers separated by commas and/or whitespace
+func Ints(s string) ([]int, error) {
+	values := []int{}
+	col := 0
+
+	for col < len(s) {
+		if isSeparator(s[col]) {
+			col++
+			continue
+		}
+
+		end := col
+		for end < len(s) && !isSeparator(s[end]) {
+			end++
+		}
+
+		v, err := strconv.Atoi(s[col:end])
+		if err != nil {
+			return nil, &Error{Col: col + 1, Err: fmt.Errorf("invalid integer %q", s[col:end])}
+		}
+
+		values = append(values, v)
+		col = end
+	}
+
+	return values, nil
+}
+
+func isSeparator(c byte) bool {
+	return c == ',' || c == ' ' || c == '\t'
+}
+
+// ParseRange parses a single Start-End range. Surrounding whitespace is ignored, but still counted in the
+// column of any error.
+func ParseRange(s string) (interval.Interval, error) {
+	trimmed := strings.TrimSpace(s)
+	col := strings.Index(s, trimmed) + 1
+
+	startText, endText, found := strings.Cut(trimmed, "-")
+	if !found {
+		return interval.Interval{}, &Error{Col: col, Err: fmt.Errorf("expected start-end, got %q", s)}
+	}
+
+	start, err := strconv.Atoi(startText)
+	if err != nil {
+		return interval.Interval{}, &Error{Col: col, Err: fmt.Errorf("invalid range start %q", startText)}
+	}
+
+	end, err := strconv.Atoi(endText)
+	if err != nil {
+		return interval.Interval{}, &Error{Col: col + len(startText) + 1, Err: fmt.Errorf("invalid range end %q", endText)}
+	}
+
+	return interval.Interval{Start: start, End: end}, nil
+}
+
+// Ranges parses a comma-separated list of Start-End ranges
+func Ranges(s string) ([]interval.Interval, error) {
+	ranges := []interval.Interval{}
+	col := 0
+
+	for part := range strings.SplitSeq(s, ",") {
+		r, err := ParseRange(part)
+		if err != nil {
+			ie := err.(*Error)
+			return nil, &Error{Col: col + ie.Col, Err: ie.Err}
+		}
+
+		ranges = append(ranges, r)
+		col += len(part) + 1
+	}
+
+	return ranges, nil
+}
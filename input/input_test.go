@@ -0,0 +1,154 @@
+package input
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/digdon/2025aoc/interval"
+)
+
+// The column an error points at, or 0 if it isn't an Error at all
+func errorCol(err error) int {
+	var ie *Error
+	if !errors.As(err, &ie) {
+		return 0
+	}
+
+	return ie.Col
+}
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []int
+		col  int
+	}{
+		{"1,2,3", []int{1, 2, 3}, 0},
+		{" 4\t-5 ,, 6 ", []int{4, -5, 6}, 0},
+		{"", []int{}, 0},
+		{"x", nil, 1},
+		{"1, x2", nil, 4},
+		{"10 20 3a", nil, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := Ints(tt.s)
+
+			if tt.col == 0 {
+				if err != nil || !slices.Equal(got, tt.want) {
+					t.Errorf("got %v, %v; want %v", got, err, tt.want)
+				}
+			} else if col := errorCol(err); col != tt.col {
+				t.Errorf("got error %v at col %d, want col %d", err, col, tt.col)
+			}
+		})
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		s    string
+		want interval.Interval
+		col  int
+	}{
+		{"3-5", interval.Interval{Start: 3, End: 5}, 0},
+		{"  3-5 ", interval.Interval{Start: 3, End: 5}, 0},
+		{"35", interval.Interval{}, 1},
+		{"x-5", interval.Interval{}, 1},
+		{"  x-5", interval.Interval{}, 3},
+		{"12-y", interval.Interval{}, 4},
+		{" 5-x", interval.Interval{}, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := ParseRange(tt.s)
+
+			if tt.col == 0 {
+				if err != nil || got != tt.want {
+					t.Errorf("got %v, %v; want %v", got, err, tt.want)
+				}
+			} else if col := errorCol(err); col != tt.col {
+				t.Errorf("got error %v at col %d, want col %d", err, col, tt.col)
+			}
+		})
+	}
+}
+
+func TestRanges(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []interval.Interval
+		col  int
+	}{
+		{"1-2,5-9", []interval.Interval{{Start: 1, End: 2}, {Start: 5, End: 9}}, 0},
+		{"1-2, 5-9", []interval.Interval{{Start: 1, End: 2}, {Start: 5, End: 9}}, 0},
+		{"1-2,x-3", nil, 5},
+		{"1-2, x-3", nil, 6},
+		{" 5-x", nil, 4},
+		{"1-2,  30-4y", nil, 10},
+		{"1-2,,3-4", nil, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := Ranges(tt.s)
+
+			if tt.col == 0 {
+				if err != nil || !slices.Equal(got, tt.want) {
+					t.Errorf("got %v, %v; want %v", got, err, tt.want)
+				}
+			} else if col := errorCol(err); col != tt.col {
+				t.Errorf("got error %v at col %d, want col %d", err, col, tt.col)
+			}
+		})
+	}
+}
+
+func TestSections(t *testing.T) {
+	got := Sections([]string{"", "a", "b", "", "  ", "c", "", "d", "e", ""})
+	want := []Section{{Line: 2, Lines: []string{"a", "b"}}, {Line: 6, Lines: []string{"c"}}, {Line: 8, Lines: []string{"d", "e"}}}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d sections, want %d", len(got), len(want))
+	}
+
+	for i := range want {
+		if got[i].Line != want[i].Line || !slices.Equal(got[i].Lines, want[i].Lines) {
+			t.Errorf("section %d is %+v, want %+v", i+1, got[i], want[i])
+		}
+	}
+}
+
+func TestGrid(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		line  int
+		col   int
+	}{
+		{"rectangular", []string{"ab", "cd", "ef"}, 0, 0},
+		{"short row", []string{"abc", "abc", "a"}, 3, 2},
+		{"long row", []string{"abc", "abcde"}, 2, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Grid(tt.lines)
+
+			if tt.line == 0 {
+				if err != nil || len(got) != len(tt.lines) || string(got[1]) != tt.lines[1] {
+					t.Errorf("got %q, %v; want the lines as a grid", got, err)
+				}
+				return
+			}
+
+			var ie *Error
+			if !errors.As(err, &ie) || ie.Line != tt.line || ie.Col != tt.col {
+				t.Errorf("got error %v, want line %d col %d", err, tt.line, tt.col)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Package interval handles inclusive ranges of integers, and sets of them
+package interval
+
+import (
+	"cmp"
+	"iter"
+	"math"
+	"slices"
+	"sort"
+)
+
+// Interval is an inclusive range of integers
+type Interval struct {
+	Start int
+	End   int
+}
+
+// Len returns the number of integers in the interval
+func (i Interval) Len() int {
+	if i.End < i.Start {
+		return 0
+	}
+
+	return i.End - i.Start + 1
+}
+
+func (i Interval) Contains(v int) bool {
+	return v >= i.Start && v <= i.End
+}
+
+func (i Interval) Overlaps(o Interval) bool {
+	return i.Start <= o.End && o.Start <= i.End
+}
+
+// Set is a collection of integers stored as sorted, non-overlapping intervals. Overlapping and touching
+// intervals are merged as they're added.
+type Set struct {
+	intervals []Interval
+}
+
+// NewSet builds a set from any number of (possibly overlapping) intervals
+func NewSet(intervals ...Interval) *Set {
+	s := &Set{}
+
+	// Sorting first means every add lands at the end, rather than shuffling the slice around
+	sorted := slices.Clone(intervals)
+	slices.SortFunc(sorted, func(a, b Interval) int { return cmp.Compare(a.Start, b.Start) })
+
+	for _, i := range sorted {
+		s.Add(i)
+	}
+
+	return s
+}
+
+// Add puts every integer in the interval into the set
+func (s *Set) Add(i Interval) {
+	if i.End < i.Start {
+		return
+	}
+
+	// Find the run of existing intervals that overlap or touch the new one, and merge them all together.
+	// Nothing can sit past either end of the int range, so the neighbour checks mustn't wrap around there.
+	first := sort.Search(len(s.intervals), func(n int) bool {
+		return i.Start == math.MinInt || s.intervals[n].End >= i.Start-1
+	})
+	last := first
+
+	for last < len(s.intervals) && (i.End == math.MaxInt || s.intervals[last].Start <= i.End+1) {
+		i.Start = min(i.Start, s.intervals[last].Start)
+		i.End = max(i.End, s.intervals[last].End)
+		last++
+	}
+
+	s.intervals = slices.Replace(s.intervals, first, last, i)
+}
+
+// Remove takes every integer in the interval out of the set
+func (s *Set) Remove(i Interval) {
+	if i.End < i.Start {
+		return
+	}
+
+	first := sort.Search(len(s.intervals), func(n int) bool { return s.intervals[n].End >= i.Start })
+	last := first
+
+	for last < len(s.intervals) && s.intervals[last].Start <= i.End {
+		last++
+	}
+
+	if first == last {
+		return
+	}
+
+	// Whatever pokes out either side of the removed interval survives. There's only something to the left
+	// when i.Start is above the minimum int (and likewise on the right), so the ±1 can't overflow.
+	remaining := []Interval{}
+
+	if s.intervals[first].Start < i.Start {
+		remaining = append(remaining, Interval{Start: s.intervals[first].Start, End: i.Start - 1})
+	}
+
+	if s.intervals[last-1].End > i.End {
+		remaining = append(remaining, Interval{Start: i.End + 1, End: s.intervals[last-1].End})
+	}
+
+	s.intervals = slices.Replace(s.intervals, first, last, remaining...)
+}
+
+// Contains reports whether v is in the set, using a binary search
+func (s *Set) Contains(v int) bool {
+	n := sort.Search(len(s.intervals), func(n int) bool { return s.intervals[n].End >= v })
+	return n < len(s.intervals) && s.intervals[n].Start <= v
+}
+
+// Len returns the total number of integers in the set
+func (s *Set) Len() int {
+	total := 0
+
+	for _, i := range s.intervals {
+		total += i.Len()
+	}
+
+	return total
+}
+
+// Count returns the number of separate intervals in the set
+func (s *Set) Count() int {
+	return len(s.intervals)
+}
+
+// All iterates over the intervals in ascending order
+func (s *Set) All() iter.Seq[Interval] {
+	return slices.Values(s.intervals)
+}
+
+// Intervals returns a copy of the intervals, in ascending order
+func (s *Set) Intervals() []Interval {
+	return slices.Clone(s.intervals)
+}
+
+func (s *Set) Clone() *Set {
+	return &Set{intervals: slices.Clone(s.intervals)}
+}
+
+// Union returns a new set holding everything in either set
+func (s *Set) Union(o *Set) *Set {
+	u := s.Clone()
+
+	for _, i := range o.intervals {
+		u.Add(i)
+	}
+
+	return u
+}
+
+// Intersect returns a new set holding everything in both sets
+func (s *Set) Intersect(o *Set) *Set {
+	result := &Set{}
+	a, b := 0, 0
+
+	// Walk both (sorted) lists together, keeping the overlap of each pair
+	for a < len(s.intervals) && b < len(o.intervals) {
+		x, y := s.intervals[a], o.intervals[b]
+
+		if x.Overlaps(y) {
+			result.intervals = append(result.intervals, Interval{Start: max(x.Start, y.Start), End: min(x.End, y.End)})
+		}
+
+		if x.End < y.End {
+			a++
+		} else {
+			b++
+		}
+	}
+
+	return result
+}
+
+// Difference returns a new set holding everything in s that isn't in o
+func (s *Set) Difference(o *Set) *Set {
+	d := s.Clone()
+
+	for _, i := range o.intervals {
+		d.Remove(i)
+	}
+
+	return d
+}
@@ -0,0 +1,240 @@
+package interval
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// The naive model of a set: every member, one at a time
+type model map[int]bool
+
+// Members of an interval, stepping carefully so an interval ending at math.MaxInt doesn't loop forever
+func members(i Interval) []int {
+	var values []int
+
+	for v := i.Start; i.Start <= i.End; v++ {
+		values = append(values, v)
+
+		if v == i.End {
+			break
+		}
+	}
+
+	return values
+}
+
+func (m model) add(i Interval) {
+	for _, v := range members(i) {
+		m[v] = true
+	}
+}
+
+func (m model) remove(i Interval) {
+	for _, v := range members(i) {
+		delete(m, v)
+	}
+}
+
+// Random intervals are kept to a small window, so the model stays small. The windows at either end of the
+// int range catch anything that overflows.
+var windows = []struct {
+	name string
+	low  int
+}{
+	{"bottom", math.MinInt},
+	{"middle", -30},
+	{"top", math.MaxInt - windowSize},
+}
+
+const windowSize = 60
+
+func randomInterval(rng *rand.Rand, low int) Interval {
+	a, b := low+rng.IntN(windowSize+1), low+rng.IntN(windowSize+1)
+
+	// Now and then an empty interval, which should be ignored
+	if a != b && rng.IntN(10) == 0 {
+		return Interval{Start: max(a, b), End: min(a, b)}
+	}
+
+	return Interval{Start: min(a, b), End: max(a, b)}
+}
+
+func randomSet(rng *rand.Rand, low int) (*Set, model) {
+	intervals := make([]Interval, rng.IntN(8))
+	m := model{}
+
+	for n := range intervals {
+		intervals[n] = randomInterval(rng, low)
+		m.add(intervals[n])
+	}
+
+	return NewSet(intervals...), m
+}
+
+// Checks a set holds exactly the model's members, in sorted intervals that neither overlap nor touch
+func checkSet(t *testing.T, s *Set, m model, low int) {
+	t.Helper()
+
+	intervals := s.Intervals()
+
+	for n, i := range intervals {
+		if i.End < i.Start {
+			t.Fatalf("empty interval %v in %v", i, intervals)
+		}
+
+		if n > 0 && intervals[n-1].End >= i.Start-1 {
+			t.Fatalf("intervals %v and %v overlap or touch in %v", intervals[n-1], i, intervals)
+		}
+	}
+
+	if s.Len() != len(m) {
+		t.Fatalf("Len is %d, want %d, in %v", s.Len(), len(m), intervals)
+	}
+
+	for _, v := range members(Interval{Start: low, End: low + windowSize}) {
+		if s.Contains(v) != m[v] {
+			t.Fatalf("Contains(%d) is %v, want %v, in %v", v, s.Contains(v), m[v], intervals)
+		}
+	}
+}
+
+func TestAddRemove(t *testing.T) {
+	for _, w := range windows {
+		t.Run(w.name, func(t *testing.T) {
+			rng := rand.New(rand.NewPCG(1, 2))
+
+			for range 500 {
+				s, m := &Set{}, model{}
+
+				for range 20 {
+					i := randomInterval(rng, w.low)
+
+					if rng.IntN(3) == 0 {
+						s.Remove(i)
+						m.remove(i)
+					} else {
+						s.Add(i)
+						m.add(i)
+					}
+
+					checkSet(t, s, m, w.low)
+				}
+			}
+		})
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	for _, w := range windows {
+		t.Run(w.name, func(t *testing.T) {
+			rng := rand.New(rand.NewPCG(3, 4))
+
+			for range 500 {
+				a, ma := randomSet(rng, w.low)
+				b, mb := randomSet(rng, w.low)
+				checkSet(t, a, ma, w.low)
+				checkSet(t, b, mb, w.low)
+
+				union, intersection, difference := model{}, model{}, model{}
+
+				for v := range ma {
+					union[v] = true
+
+					if mb[v] {
+						intersection[v] = true
+					} else {
+						difference[v] = true
+					}
+				}
+
+				for v := range mb {
+					union[v] = true
+				}
+
+				checkSet(t, a.Union(b), union, w.low)
+				checkSet(t, a.Intersect(b), intersection, w.low)
+				checkSet(t, a.Difference(b), difference, w.low)
+
+				// None of them should touch the sets they started from
+				checkSet(t, a, ma, w.low)
+				checkSet(t, b, mb, w.low)
+			}
+		})
+	}
+}
+
+// Cases right at the ends of the int range, where a stray ±1 wraps around
+func TestExtremes(t *testing.T) {
+	tests := []struct {
+		name   string
+		add    []Interval
+		remove []Interval
+		want   []Interval
+	}{
+		{
+			name: "touching at the bottom",
+			add:  []Interval{{math.MinInt, math.MinInt + 2}, {math.MinInt + 3, math.MinInt + 5}},
+			want: []Interval{{math.MinInt, math.MinInt + 5}},
+		},
+		{
+			name: "added at the bottom after higher intervals",
+			add:  []Interval{{5, 10}, {math.MaxInt - 1, math.MaxInt}, {math.MinInt, math.MinInt}},
+			want: []Interval{{math.MinInt, math.MinInt}, {5, 10}, {math.MaxInt - 1, math.MaxInt}},
+		},
+		{
+			name: "touching at the top",
+			add:  []Interval{{math.MaxInt - 2, math.MaxInt}, {math.MaxInt - 5, math.MaxInt - 3}},
+			want: []Interval{{math.MaxInt - 5, math.MaxInt}},
+		},
+		{
+			name: "added at the top before lower intervals",
+			add:  []Interval{{math.MaxInt, math.MaxInt}, {math.MinInt, math.MinInt + 1}, {-3, 3}},
+			want: []Interval{{math.MinInt, math.MinInt + 1}, {-3, 3}, {math.MaxInt, math.MaxInt}},
+		},
+		{
+			name:   "removed from the bottom",
+			add:    []Interval{{math.MinInt, math.MinInt + 4}},
+			remove: []Interval{{math.MinInt, math.MinInt + 1}},
+			want:   []Interval{{math.MinInt + 2, math.MinInt + 4}},
+		},
+		{
+			name:   "removed from the top",
+			add:    []Interval{{math.MaxInt - 4, math.MaxInt}},
+			remove: []Interval{{math.MaxInt - 1, math.MaxInt}},
+			want:   []Interval{{math.MaxInt - 4, math.MaxInt - 2}},
+		},
+		{
+			name:   "everything below the top removed",
+			add:    []Interval{{math.MinInt, math.MinInt + 2}, {0, 0}, {math.MaxInt - 1, math.MaxInt}},
+			remove: []Interval{{math.MinInt, math.MaxInt - 1}},
+			want:   []Interval{{math.MaxInt, math.MaxInt}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Set{}
+
+			for _, i := range tt.add {
+				s.Add(i)
+			}
+
+			for _, i := range tt.remove {
+				s.Remove(i)
+			}
+
+			if got := s.Intervals(); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+
+			// Building the set in one go sorts the intervals first, which mustn't overflow either
+			if tt.remove == nil {
+				if got := NewSet(tt.add...).Intervals(); !slices.Equal(got, tt.want) {
+					t.Errorf("NewSet gave %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
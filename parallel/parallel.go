@@ -0,0 +1,119 @@
+// Package parallel runs independent tasks across a bounded pool of goroutines
+package parallel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var workers atomic.Int64
+
+func init() {
+	workers.Store(1)
+}
+
+// SetWorkers sets the default pool size used by Sum. Anything below 1 is treated as 1 (ie, sequential).
+func SetWorkers(n int) {
+	workers.Store(int64(max(n, 1)))
+}
+
+// Workers returns the default pool size
+func Workers() int {
+	return int(workers.Load())
+}
+
+// Map calls task for every index in [0, n) using at most the given number of goroutines. Results and errors
+// are returned in index order, regardless of the order the tasks finish in. Once ctx is cancelled, tasks
+// that haven't started yet are skipped and get the context's error.
+func Map[T any](ctx context.Context, workers, n int, task func(ctx context.Context, i int) (T, error)) ([]T, []error) {
+	results := make([]T, n)
+	errs := make([]error, n)
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for range min(max(workers, 1), n) {
+		wg.Go(func() {
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+
+				results[i], errs[i] = task(ctx, i)
+			}
+		})
+	}
+
+	for i := range n {
+		indexes <- i
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	return results, errs
+}
+
+// Sum adds up f(i) for every index in [0, n), spreading the calls across the default pool size
+func Sum(n int, f func(i int) int) int {
+	if Workers() == 1 {
+		total := 0
+
+		for i := range n {
+			total += f(i)
+		}
+
+		return total
+	}
+
+	values, _ := Map(context.Background(), Workers(), n, func(_ context.Context, i int) (int, error) {
+		return f(i), nil
+	})
+
+	total := 0
+
+	for _, v := range values {
+		total += v
+	}
+
+	return total
+}
+
+// ErrTimeout is returned by WithTimeout when the timeout passes before f finishes
+var ErrTimeout = errors.New("timed out")
+
+// WithTimeout runs f, giving up once ctx is cancelled or the timeout (if non-zero) passes. Go has no way
+// to stop a goroutine from the outside, so f is handed the timed context and should return once it's done.
+// An f that ignores it keeps running in the background until it finishes.
+func WithTimeout[T any](ctx context.Context, timeout time.Duration, f func(ctx context.Context) (T, error)) (T, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, timeout, fmt.Errorf("%w after %v", ErrTimeout, timeout))
+		defer cancel()
+	}
+
+	type result struct {
+		value T
+		err   error
+	}
+
+	done := make(chan result, 1)
+
+	go func() {
+		value, err := f(ctx)
+		done <- result{value: value, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		var zero T
+		return zero, context.Cause(ctx)
+	}
+}
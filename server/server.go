@@ -0,0 +1,266 @@
+// Package server exposes the solvers over HTTP, so other tools can use them without shelling out to the
+// aoc command. Inputs are posted as plain text and answers come back as JSON.
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/parallel"
+	"github.com/digdon/2025aoc/solver"
+	"github.com/digdon/2025aoc/trace"
+)
+
+type Config struct {
+	// Largest input accepted, in bytes
+	MaxBody int64
+
+	// How long a single request can spend solving. Days that implement solver.ContextSolver stop when it
+	// passes; any other day carries on until it finishes, keeping its slot and day lock, so a solve that
+	// never ends ties them up for as long as the server runs.
+	Timeout time.Duration
+
+	// How many solves can run at once. Requests beyond that are turned away, rather than queued.
+	MaxConcurrent int
+}
+
+// Response is the body returned for a solve
+type Response struct {
+	Year      int            `json:"year"`
+	Day       int            `json:"day"`
+	Part      int            `json:"part"`
+	Answer    string         `json:"answer"`
+	ParseTime time.Duration  `json:"parse_ns"`
+	SolveTime time.Duration  `json:"solve_ns"`
+	Trace     []trace.Record `json:"trace,omitempty"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+type server struct {
+	cfg Config
+
+	// Counts solves in progress. A slot is held until the solver actually returns, even if the request
+	// timed out first, because only solvers that watch their context can be stopped part way through.
+	slots chan struct{}
+
+	// A day being traced has to run alone, so that its capture only sees its own records. Traced solves
+	// take the write lock for their day and everything else takes the read lock.
+	daysMu sync.Mutex
+	days   map[puzzle]*sync.RWMutex
+}
+
+type puzzle struct {
+	year, day int
+}
+
+// New returns a handler serving:
+//
+//	GET  /years/{year}/days                    the days of a year that can be solved
+//	POST /years/{year}/days/{day}/parts/{part} solve a part, with the input as the body; ?trace=info or
+//	                                           ?trace=debug includes the solver's trace records in the response
+//
+// The same routes without the /years/{year} prefix are for solver.DefaultYear.
+func New(cfg Config) http.Handler {
+	s := &server{
+		cfg:   cfg,
+		slots: make(chan struct{}, max(cfg.MaxConcurrent, 1)),
+		days:  map[puzzle]*sync.RWMutex{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /days", s.listDays)
+	mux.HandleFunc("POST /days/{day}/parts/{part}", s.solve)
+	mux.HandleFunc("GET /years/{year}/days", s.listDays)
+	mux.HandleFunc("POST /years/{year}/days/{day}/parts/{part}", s.solve)
+
+	return mux
+}
+
+func (s *server) listDays(w http.ResponseWriter, r *http.Request) {
+	year, err := pathYear(r)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string][]int{"days": solver.Days(year)})
+}
+
+func (s *server) solve(w http.ResponseWriter, r *http.Request) {
+	year, err := pathYear(r)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	day, err := strconv.Atoi(r.PathValue("day"))
+	if err != nil || !solver.Registered(year, day) {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no solver for %d day %q", year, r.PathValue("day")))
+		return
+	}
+
+	part, err := strconv.Atoi(r.PathValue("part"))
+	if err != nil || (part != 1 && part != 2) {
+		writeError(w, http.StatusNotFound, fmt.Errorf("invalid part %q", r.PathValue("part")))
+		return
+	}
+
+	traceLevel, tracing, err := parseTraceLevel(r.URL.Query().Get("trace"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	lines, err := input.Read(http.MaxBytesReader(w, r.Body, s.cfg.MaxBody))
+	if err != nil {
+		var tooBig *http.MaxBytesError
+		if errors.As(err, &tooBig) {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("input is larger than %d bytes", tooBig.Limit))
+		} else {
+			writeError(w, http.StatusBadRequest, err)
+		}
+		return
+	}
+
+	// Turn the request away if every slot is busy
+	select {
+	case s.slots <- struct{}{}:
+	default:
+		w.Header().Set("Retry-After", "1")
+		writeError(w, http.StatusServiceUnavailable, errors.New("too many solves in progress"))
+		return
+	}
+
+	resp, err := parallel.WithTimeout(r.Context(), s.cfg.Timeout, func(ctx context.Context) (resp Response, err error) {
+		defer func() { <-s.slots }()
+
+		// The solve runs on a goroutine of its own, out of reach of net/http's recovery, so a panicking
+		// solver would take the whole server down with it
+		defer func() {
+			if p := recover(); p != nil {
+				err = fmt.Errorf("%d day %d part %d panicked: %v", year, day, part, p)
+			}
+		}()
+
+		lock := s.dayLock(puzzle{year, day})
+		if tracing {
+			lock.Lock()
+			defer lock.Unlock()
+		} else {
+			lock.RLock()
+			defer lock.RUnlock()
+		}
+
+		return run(ctx, year, day, part, lines, traceLevel, tracing)
+	})
+
+	switch {
+	case err == nil:
+		writeJSON(w, http.StatusOK, resp)
+	case errors.Is(err, parallel.ErrTimeout):
+		writeError(w, http.StatusGatewayTimeout, err)
+	case errors.Is(err, solver.ErrNoSolution):
+		writeError(w, http.StatusNotFound, err)
+	case errors.As(err, new(input.Errors)), errors.As(err, new(*input.Error)):
+		writeError(w, http.StatusUnprocessableEntity, err)
+	default:
+		writeError(w, http.StatusInternalServerError, err)
+	}
+}
+
+func run(ctx context.Context, year, day, part int, lines []string, traceLevel slog.Level, tracing bool) (resp Response, err error) {
+	resp = Response{Year: year, Day: day, Part: part}
+
+	if tracing {
+		capture := trace.StartCapture(year, day, traceLevel)
+		defer func() { resp.Trace = capture.Stop() }()
+	}
+
+	s, err := solver.Lookup(year, day)
+	if err != nil {
+		return resp, err
+	}
+
+	start := time.Now()
+	if err := s.Parse(lines); err != nil {
+		return resp, err
+	}
+	resp.ParseTime = time.Since(start)
+
+	start = time.Now()
+	answer, err := solver.PartContext(ctx, s, part)
+	if err != nil {
+		return resp, err
+	}
+	resp.SolveTime = time.Since(start)
+	resp.Answer = fmt.Sprint(answer)
+
+	return resp, nil
+}
+
+func (s *server) dayLock(p puzzle) *sync.RWMutex {
+	s.daysMu.Lock()
+	defer s.daysMu.Unlock()
+
+	if s.days[p] == nil {
+		s.days[p] = &sync.RWMutex{}
+	}
+
+	return s.days[p]
+}
+
+// The year from the path, for the routes that have one
+func pathYear(r *http.Request) (int, error) {
+	value := r.PathValue("year")
+	if value == "" {
+		return solver.DefaultYear, nil
+	}
+
+	year, err := strconv.Atoi(value)
+	if err != nil || len(solver.Days(year)) == 0 {
+		return 0, fmt.Errorf("no solvers for year %q", value)
+	}
+
+	return year, nil
+}
+
+func parseTraceLevel(value string) (slog.Level, bool, error) {
+	switch value {
+	case "":
+		return 0, false, nil
+	case "info":
+		return slog.LevelInfo, true, nil
+	case "debug":
+		return slog.LevelDebug, true, nil
+	}
+
+	return 0, false, fmt.Errorf("invalid trace level %q (info or debug)", value)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
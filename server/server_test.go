@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/digdon/2025aoc/2025/day_11"
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/solver"
+)
+
+// The tests solve a made up year, so they don't depend on how long any real day takes
+const testYear = 1
+
+// Tells the tests when a solve has taken its slot
+var started = make(chan struct{}, 10)
+
+// waitingSolver rejects any line saying "bad", and otherwise solves by waiting for its context to end
+type waitingSolver struct{}
+
+func (waitingSolver) Parse(lines []string) error {
+	var errs input.Errors
+
+	for i, line := range lines {
+		if line == "bad" {
+			errs.Add(i+1, line, "anything but bad", errors.New("bad line"))
+		}
+	}
+
+	return errs.Err()
+}
+
+func (waitingSolver) Part1() (any, error) { return nil, errors.New("needs a context") }
+func (waitingSolver) Part2() (any, error) { return nil, errors.New("needs a context") }
+
+func (waitingSolver) PartContext(ctx context.Context, part int) (any, error) {
+	started <- struct{}{}
+	<-ctx.Done()
+
+	return nil, context.Cause(ctx)
+}
+
+// panickingSolver blows up as soon as it's asked for an answer
+type panickingSolver struct{}
+
+func (panickingSolver) Parse(lines []string) error { return nil }
+func (panickingSolver) Part1() (any, error)        { panic("no answer here") }
+func (panickingSolver) Part2() (any, error)        { panic("no answer here") }
+
+func init() {
+	solver.Register(testYear, 1, func() solver.Solver { return waitingSolver{} })
+	solver.Register(testYear, 2, func() solver.Solver { return panickingSolver{} })
+}
+
+func startServer(t *testing.T, cfg Config) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(New(cfg))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+const waitingPath = "/years/1/days/1/parts/1"
+
+func post(ctx context.Context, t *testing.T, srv *httptest.Server, path, body string) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	return resp
+}
+
+func TestTooLarge(t *testing.T) {
+	srv := startServer(t, Config{MaxBody: 16, Timeout: time.Second, MaxConcurrent: 1})
+
+	if resp := post(context.Background(), t, srv, waitingPath, strings.Repeat("input\n", 10)); resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusRequestEntityTooLarge)
+	}
+}
+
+func TestBadInput(t *testing.T) {
+	srv := startServer(t, Config{MaxBody: 1024, Timeout: time.Second, MaxConcurrent: 1})
+
+	if resp := post(context.Background(), t, srv, waitingPath, "good\nbad\n"); resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	srv := startServer(t, Config{MaxBody: 1024, Timeout: 20 * time.Millisecond, MaxConcurrent: 1})
+
+	if resp := post(context.Background(), t, srv, waitingPath, "good\n"); resp.StatusCode != http.StatusGatewayTimeout {
+		t.Fatalf("got status %d, want %d", resp.StatusCode, http.StatusGatewayTimeout)
+	}
+	<-started
+
+	// The solver stops with the timeout, giving its slot back. That can land just after the response, so
+	// a busy server is allowed for a moment.
+	deadline := time.Now().Add(time.Second)
+
+	for {
+		resp := post(context.Background(), t, srv, waitingPath, "good\n")
+		if resp.StatusCode == http.StatusGatewayTimeout {
+			<-started
+			break
+		}
+
+		if resp.StatusCode != http.StatusServiceUnavailable || time.Now().After(deadline) {
+			t.Fatalf("got status %d after a timeout, want %d", resp.StatusCode, http.StatusGatewayTimeout)
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestBusy(t *testing.T) {
+	srv := startServer(t, Config{MaxBody: 1024, MaxConcurrent: 1})
+
+	// Hold the only slot until the first request is cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+waitingPath, strings.NewReader("good\n"))
+		if resp, err := srv.Client().Do(req); err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	<-started
+
+	resp := post(context.Background(), t, srv, waitingPath, "good\n")
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusServiceUnavailable)
+	}
+
+	if resp.Header.Get("Retry-After") == "" {
+		t.Error("no Retry-After header on a busy response")
+	}
+
+	cancel()
+	<-done
+}
+
+func TestPanic(t *testing.T) {
+	srv := startServer(t, Config{MaxBody: 1024, Timeout: time.Second, MaxConcurrent: 1})
+
+	// Twice, to show the server (and its only slot) survived the first
+	for range 2 {
+		if resp := post(context.Background(), t, srv, "/years/1/days/2/parts/1", "anything\n"); resp.StatusCode != http.StatusInternalServerError {
+			t.Fatalf("got status %d, want %d", resp.StatusCode, http.StatusInternalServerError)
+		}
+	}
+}
+
+// Devices that loop back on themselves have to be caught by day 11, as a stack overflow is beyond any recover
+func TestDeviceLoop(t *testing.T) {
+	srv := startServer(t, Config{MaxBody: 1024, Timeout: time.Second, MaxConcurrent: 1})
+
+	for part := 1; part <= 2; part++ {
+		path := fmt.Sprintf("/years/2025/days/11/parts/%d", part)
+
+		if resp := post(context.Background(), t, srv, path, "you: a\na: you\nsvr: b\nb: svr\n"); resp.StatusCode != http.StatusInternalServerError {
+			t.Errorf("part %d: got status %d, want %d", part, resp.StatusCode, http.StatusInternalServerError)
+		}
+	}
+
+	if resp := post(context.Background(), t, srv, "/years/2025/days/11/parts/1", "you: a out\na: out\n"); resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d after a loop, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
@@ -0,0 +1,20 @@
+package solver
+
+import "context"
+
+// ContextSolver is implemented by days whose solves can run long enough to need stopping part way
+// through. PartContext gives up, returning the context's cause, once ctx is cancelled.
+type ContextSolver interface {
+	Solver
+	PartContext(ctx context.Context, part int) (any, error)
+}
+
+// PartContext runs the requested part of a solver, stopping early on cancellation if the solver supports
+// it. Solvers that don't run to completion whatever happens to ctx.
+func PartContext(ctx context.Context, s Solver, part int) (any, error) {
+	if cs, ok := s.(ContextSolver); ok {
+		return cs.PartContext(ctx, part)
+	}
+
+	return Part(s, part)
+}
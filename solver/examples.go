@@ -0,0 +1,39 @@
+package solver
+
+// Example is one of the worked examples from a puzzle description, along with its published answers.
+// A nil answer means the example doesn't apply to that part (ie, day 11 has a different example for each part).
+type Example struct {
+	Name  string
+	Input string
+	Part1 any
+	Part2 any
+
+	// Optional constructor for examples that need different settings from the real input
+	New func() Solver
+}
+
+// Examples by year, then by day
+var examples = map[int]map[int][]Example{}
+
+// RegisterExamples records the worked examples for a day, for regression checking
+func RegisterExamples(year, day int, dayExamples ...Example) {
+	if examples[year] == nil {
+		examples[year] = map[int][]Example{}
+	}
+
+	examples[year][day] = append(examples[year][day], dayExamples...)
+}
+
+// Examples returns the worked examples registered for a day
+func Examples(year, day int) []Example {
+	return examples[year][day]
+}
+
+// NewFor returns a Solver set up for the example
+func (e Example) NewFor(year, day int) (Solver, error) {
+	if e.New != nil {
+		return e.New(), nil
+	}
+
+	return Lookup(year, day)
+}
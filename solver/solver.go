@@ -0,0 +1,88 @@
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Solver is implemented by every day. Parse is called once with the raw puzzle input lines, after which
+// Part1 and Part2 can be called (in any order) to produce the answers.
+type Solver interface {
+	Parse(lines []string) error
+	Part1() (any, error)
+	Part2() (any, error)
+}
+
+// ErrNoSolution is returned by a part that doesn't have a puzzle (ie, day 12 part 2)
+var ErrNoSolution = errors.New("no solution for this part")
+
+// DefaultYear is the event the runner works on unless told otherwise
+const DefaultYear = 2025
+
+// Each year has its own registry of days
+var registry = map[int]map[int]func() Solver{}
+
+// Register makes a day available to the runner. It's meant to be called from the day package's init().
+// A constructor is registered, rather than a Solver, so that every run starts with fresh state.
+func Register(year, day int, newSolver func() Solver) {
+	if registry[year] == nil {
+		registry[year] = map[int]func() Solver{}
+	}
+
+	if _, exists := registry[year][day]; exists {
+		panic(fmt.Sprintf("solver: %d day %d registered twice", year, day))
+	}
+
+	registry[year][day] = newSolver
+}
+
+// Lookup returns a new Solver for the given day
+func Lookup(year, day int) (Solver, error) {
+	newSolver, found := registry[year][day]
+	if !found {
+		return nil, fmt.Errorf("no solver registered for %d day %d", year, day)
+	}
+
+	return newSolver(), nil
+}
+
+// Years returns all of the years with registered days, in order
+func Years() []int {
+	return sortedKeys(registry)
+}
+
+// Days returns all of the registered days for a year, in order
+func Days(year int) []int {
+	return sortedKeys(registry[year])
+}
+
+func sortedKeys[T any](m map[int]T) []int {
+	keys := []int{}
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Ints(keys)
+
+	return keys
+}
+
+// Part runs the requested part (1 or 2) of a solver
+func Part(s Solver, part int) (any, error) {
+	switch part {
+	case 1:
+		return s.Part1()
+	case 2:
+		return s.Part2()
+	}
+
+	return nil, fmt.Errorf("invalid part %d", part)
+}
+
+// Registered reports whether a solver exists for the given day
+func Registered(year, day int) bool {
+	_, found := registry[year][day]
+	return found
+}
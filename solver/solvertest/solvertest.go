@@ -0,0 +1,77 @@
+// Package solvertest holds the fuzz and benchmark loops that the days' tests share
+package solvertest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/digdon/2025aoc/generate"
+	"github.com/digdon/2025aoc/input"
+	"github.com/digdon/2025aoc/solver"
+)
+
+// How long each part gets on a fuzzed input. Solvers that watch their context stop there; anything slower
+// than this on a fuzzer-sized input is worth a look anyway.
+const fuzzTimeout = time.Second
+
+// FuzzParse feeds mangled inputs to a day's parser, seeded with its worked examples. Errors are fine, but
+// nothing may panic. Inputs that parse, or only have bad lines, are solved too, as that's what aoc run
+// -skip-bad does with them. Run with go test -fuzz=FuzzParse.
+func FuzzParse(f *testing.F, year, day int, newSolver func() solver.Solver) {
+	for _, example := range solver.Examples(year, day) {
+		f.Add(example.Input)
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		s := newSolver()
+
+		if err := s.Parse(input.Split(text)); err != nil && !errors.As(err, new(input.Errors)) {
+			return
+		}
+
+		for part := 1; part <= 2; part++ {
+			ctx, cancel := context.WithTimeout(context.Background(), fuzzTimeout)
+			solver.PartContext(ctx, s, part)
+			cancel()
+		}
+	})
+}
+
+// BenchmarkVariants times the default implementation of each part against the alternates a day keeps in
+// Variants, on a generated input of roughly the real input's size
+func BenchmarkVariants(b *testing.B, year, day int, newSolver func() solver.Solver) {
+	g, err := generate.Lookup(year, day)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	lines, err := generate.Generate(year, day, g.Default, 1)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	s := newSolver()
+	if err := s.Parse(lines); err != nil {
+		b.Fatal(err)
+	}
+
+	for _, part := range []int{1, 2} {
+		variants := []solver.Variant{{Name: "default", Part: part, Solve: func() (any, error) { return solver.Part(s, part) }}}
+		variants = append(variants, solver.Variants(s, part)...)
+
+		for _, v := range variants {
+			b.Run(fmt.Sprintf("part %d/%s", part, v.Name), func(b *testing.B) {
+				b.ReportAllocs()
+
+				for b.Loop() {
+					if _, err := v.Solve(); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package solver
+
+// Variant is an alternate implementation of one part of a day, kept around for comparison
+type Variant struct {
+	Name  string
+	Part  int
+	Solve func() (any, error)
+}
+
+// VariantSolver is implemented by days that keep competing algorithms side by side. The variants
+// work from the solver's parsed input, so Parse must be called first.
+type VariantSolver interface {
+	Solver
+	Variants() []Variant
+}
+
+// Variants returns the alternate implementations for a part, if the solver has any
+func Variants(s Solver, part int) []Variant {
+	vs, ok := s.(VariantSolver)
+	if !ok {
+		return nil
+	}
+
+	variants := []Variant{}
+
+	for _, v := range vs.Variants() {
+		if v.Part == part {
+			variants = append(variants, v)
+		}
+	}
+
+	return variants
+}
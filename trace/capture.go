@@ -0,0 +1,98 @@
+package trace
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// Record is a captured trace record, in a form that's easy to turn into JSON
+type Record struct {
+	Level   string         `json:"level"`
+	Message string         `json:"msg"`
+	Attrs   map[string]any `json:"attrs,omitempty"`
+}
+
+// Captures in progress, by puzzle
+var (
+	capturesMu sync.Mutex
+	captures   = map[puzzle]*Capture{}
+)
+
+// Capture collects a day's trace records, on top of whatever the current handler is doing with them
+type Capture struct {
+	puzzle  puzzle
+	level   slog.Level
+	mu      sync.Mutex
+	records []Record
+}
+
+// StartCapture begins collecting the records a day writes at or above level. Records are collected from
+// every solver for that day, so callers running a day more than once at a time need to keep the other
+// runs out while capturing. Only one capture per day can be active; a second replaces the first.
+func StartCapture(year, day int, level slog.Level) *Capture {
+	c := &Capture{level: level, puzzle: puzzle{year, day}}
+
+	capturesMu.Lock()
+	captures[c.puzzle] = c
+	capturesMu.Unlock()
+
+	return c
+}
+
+// Stop ends the capture, returning everything collected
+func (c *Capture) Stop() []Record {
+	capturesMu.Lock()
+	if captures[c.puzzle] == c {
+		delete(captures, c.puzzle)
+	}
+	capturesMu.Unlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.records
+}
+
+func captureFor(p puzzle) *Capture {
+	capturesMu.Lock()
+	defer capturesMu.Unlock()
+
+	return captures[p]
+}
+
+func (c *Capture) add(r slog.Record, attrs []slog.Attr) {
+	record := Record{Level: r.Level.String(), Message: r.Message}
+
+	add := func(a slog.Attr) {
+		if record.Attrs == nil {
+			record.Attrs = map[string]any{}
+		}
+		record.Attrs[a.Key] = a.Value.Resolve().Any()
+	}
+
+	for _, a := range attrs {
+		add(a)
+	}
+
+	r.Attrs(func(a slog.Attr) bool {
+		add(a)
+		return true
+	})
+
+	c.mu.Lock()
+	c.records = append(c.records, record)
+	c.mu.Unlock()
+}
+
+// Enabled and Handle for the capture side of a dayHandler
+func (h *dayHandler) captureEnabled(level slog.Level) bool {
+	c := captureFor(h.puzzle)
+	return c != nil && level >= c.level
+}
+
+func (h *dayHandler) capture(_ context.Context, r slog.Record) {
+	if c := captureFor(h.puzzle); c != nil && r.Level >= c.level {
+		c.add(r, h.attrs)
+	}
+}
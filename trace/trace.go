@@ -0,0 +1,85 @@
+// Package trace lets the solvers log their intermediate state (ranges merged, beams per row, circuits
+// formed) through log/slog. Nothing is written unless the runner installs a handler, so the calls can stay
+// in the code for good, rather than being commented in and out.
+package trace
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+)
+
+var current atomic.Pointer[slog.Handler]
+
+func init() {
+	SetHandler(slog.DiscardHandler)
+}
+
+// SetHandler sends all tracing to h. Loggers already handed out by For pick up the change.
+func SetHandler(h slog.Handler) {
+	current.Store(&h)
+}
+
+// For returns the logger for a day. Every record it writes carries the year and day, which keeps things
+// readable when days run in parallel. It's meant to be stored in a package variable at init.
+func For(year, day int) *slog.Logger {
+	return slog.New(&dayHandler{puzzle: puzzle{year, day}})
+}
+
+// A year and day, identifying one puzzle
+type puzzle struct {
+	year, day int
+}
+
+// Forwards to whichever handler is current at the time of each call, replaying any attributes and groups
+// added along the way, and to any capture in progress for the day
+type dayHandler struct {
+	puzzle puzzle
+	with   []func(slog.Handler) slog.Handler
+
+	// Attributes added along the way, for captures (which don't bother with groups)
+	attrs []slog.Attr
+}
+
+func (h *dayHandler) handler() slog.Handler {
+	handler := (*current.Load()).WithAttrs([]slog.Attr{slog.Int("year", h.puzzle.year), slog.Int("day", h.puzzle.day)})
+
+	for _, with := range h.with {
+		handler = with(handler)
+	}
+
+	return handler
+}
+
+func (h *dayHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return (*current.Load()).Enabled(ctx, level) || h.captureEnabled(level)
+}
+
+func (h *dayHandler) Handle(ctx context.Context, r slog.Record) error {
+	h.capture(ctx, r)
+
+	if handler := h.handler(); handler.Enabled(ctx, r.Level) {
+		return handler.Handle(ctx, r)
+	}
+
+	return nil
+}
+
+func (h *dayHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	added := h.add(func(handler slog.Handler) slog.Handler { return handler.WithAttrs(attrs) })
+	added.attrs = append(added.attrs, attrs...)
+
+	return added
+}
+
+func (h *dayHandler) WithGroup(name string) slog.Handler {
+	return h.add(func(handler slog.Handler) slog.Handler { return handler.WithGroup(name) })
+}
+
+func (h *dayHandler) add(with func(slog.Handler) slog.Handler) *dayHandler {
+	return &dayHandler{
+		puzzle: h.puzzle,
+		with:   append(h.with[:len(h.with):len(h.with)], with),
+		attrs:  h.attrs[:len(h.attrs):len(h.attrs)],
+	}
+}
@@ -0,0 +1,101 @@
+package visual
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/digdon/2025aoc/grid"
+)
+
+// Player draws frames to a terminal, either at a fixed rate or one at a time as Enter is pressed
+type Player struct {
+	out    io.Writer
+	input  *bufio.Reader
+	colour bool
+	step   bool
+	delay  time.Duration
+
+	frames    int
+	lastFrame time.Time
+}
+
+// NewPlayer returns a player writing to out. With step set, it waits for a line from in after each frame
+// (entering q switches to animating for the rest of the run); otherwise it animates at fps frames per second,
+// where 0 means as fast as possible.
+func NewPlayer(out io.Writer, in io.Reader, fps int, step, colour bool) *Player {
+	p := &Player{out: out, input: bufio.NewReader(in), colour: colour, step: step}
+
+	if fps > 0 {
+		p.delay = time.Second / time.Duration(fps)
+	}
+
+	return p
+}
+
+// Sink returns the sink to hand to a Source
+func (p *Player) Sink() Sink {
+	return p.Play
+}
+
+// Play draws a single frame, then waits before the next one
+func (p *Player) Play(f Frame) {
+	p.frames++
+
+	if p.step {
+		fmt.Fprint(p.out, p.render(f), "[Enter for the next frame, q to play the rest] ")
+
+		line, err := p.input.ReadString('\n')
+		if err != nil || strings.TrimSpace(line) == "q" {
+			p.step = false
+		}
+
+		return
+	}
+
+	if wait := p.delay - time.Since(p.lastFrame); wait > 0 {
+		time.Sleep(wait)
+	}
+
+	fmt.Fprint(p.out, p.render(f))
+	p.lastFrame = time.Now()
+}
+
+// Frames returns the number of frames played so far
+func (p *Player) Frames() int {
+	return p.frames
+}
+
+// Builds the whole frame as one string, so it can go out in a single write without flickering
+func (p *Player) render(f Frame) string {
+	var b strings.Builder
+
+	// Home the cursor and clear the screen
+	b.WriteString("\x1b[H\x1b[2J")
+	fmt.Fprintf(&b, "%s (frame %d)\n", f.Title, p.frames)
+
+	current := Default
+
+	for y := range f.Cells.Height {
+		for x := range f.Cells.Width {
+			cell := f.Cells.Get(grid.Point{X: x, Y: y})
+
+			if p.colour && cell.Colour != current {
+				b.WriteString(escapes[cell.Colour])
+				current = cell.Colour
+			}
+
+			b.WriteByte(cell.Char)
+		}
+
+		b.WriteByte('\n')
+	}
+
+	if p.colour && current != Default {
+		b.WriteString(escapes[Default])
+	}
+
+	return b.String()
+}
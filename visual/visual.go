@@ -0,0 +1,71 @@
+// Package visual draws grids to the terminal, so the grid-based days can be watched as they work. Solvers
+// that implement Source are handed a Sink, and feed it a Frame at each step of their main loop.
+package visual
+
+import (
+	"github.com/digdon/2025aoc/grid"
+)
+
+// Colour is an ANSI foreground colour
+type Colour int
+
+const (
+	Default Colour = iota
+	Red
+	Green
+	Yellow
+	Blue
+	Magenta
+	Cyan
+	White
+	Grey
+)
+
+// The escape sequence to switch to each colour
+var escapes = map[Colour]string{
+	Default: "\x1b[0m",
+	Red:     "\x1b[31m",
+	Green:   "\x1b[32m",
+	Yellow:  "\x1b[33m",
+	Blue:    "\x1b[34m",
+	Magenta: "\x1b[35m",
+	Cyan:    "\x1b[36m",
+	White:   "\x1b[97m",
+	Grey:    "\x1b[90m",
+}
+
+type Cell struct {
+	Char   byte
+	Colour Colour
+}
+
+// Frame is a single picture in an animation
+type Frame struct {
+	Title string
+	Cells *grid.Dense[Cell]
+}
+
+// NewFrame returns a frame filled with spaces
+func NewFrame(title string, width, height int) Frame {
+	cells := grid.NewDense[Cell](width, height)
+
+	for p := range cells.All() {
+		cells.Set(p, Cell{Char: ' '})
+	}
+
+	return Frame{Title: title, Cells: cells}
+}
+
+// Set draws a character into the frame, ignoring anything out of bounds
+func (f Frame) Set(p grid.Point, char byte, colour Colour) {
+	f.Cells.Set(p, Cell{Char: char, Colour: colour})
+}
+
+// Sink receives frames as a solver produces them
+type Sink func(Frame)
+
+// Source is implemented by solvers that can be visualised. The sink is set before the parts are run, and
+// a nil sink (the default) means nobody is watching, so no frames should be built.
+type Source interface {
+	Visualise(sink Sink)
+}